@@ -0,0 +1,210 @@
+package charset
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// leaf the bits of the 256 code points of a page, like fontconfig's FcCharLeaf
+type leaf [8]uint32
+
+// empty whether no bit of the leaf is set
+func (l leaf) empty() bool {
+	return l == leaf{}
+}
+
+// Bitmap a sparse bitmap of code points, like fontconfig's FcCharSet: only the leaves of
+// pages holding a code point exist, sorted by page number. every set operation walks
+// both bitmaps once.
+type Bitmap struct {
+	// pages the page numbers, code point >> 8
+	pages  []uint64
+	leaves []leaf
+}
+
+// NewBitmap build the Bitmap of the characters of c
+func NewBitmap(c Charset) Bitmap {
+	var b Bitmap
+	for _, r := range c {
+		b.AddRange(r.Min, r.Max)
+	}
+	return b
+}
+
+// Bitmap the bitmap view of the Charset
+func (c Charset) Bitmap() Bitmap {
+	return NewBitmap(c)
+}
+
+// leaf find the leaf of page, creating it if needed
+func (b *Bitmap) leaf(page uint64) *leaf {
+	n := len(b.pages)
+	// characters are mostly added in ascending order
+	if n > 0 && b.pages[n-1] == page {
+		return &b.leaves[n-1]
+	}
+	i := sort.Search(n, func(i int) bool { return b.pages[i] >= page })
+	if i < n && b.pages[i] == page {
+		return &b.leaves[i]
+	}
+	b.pages = append(b.pages, 0)
+	b.leaves = append(b.leaves, leaf{})
+	copy(b.pages[i+1:], b.pages[i:])
+	copy(b.leaves[i+1:], b.leaves[i:])
+	b.pages[i] = page
+	b.leaves[i] = leaf{}
+	return &b.leaves[i]
+}
+
+// Add add the code point c
+func (b *Bitmap) Add(c uint64) {
+	b.AddRange(c, c)
+}
+
+// AddRange add the code points from min to max, inclusive, up to MaxCodePoint
+func (b *Bitmap) AddRange(min, max uint64) {
+	if max > MaxCodePoint {
+		max = MaxCodePoint
+	}
+	for c := min; c <= max; {
+		l := b.leaf(c >> 8)
+		// the last code point of the range on this page
+		end := c | 0xff
+		if end > max {
+			end = max
+		}
+		for c <= end {
+			word := (c & 0xff) >> 5
+			// the bits from c up to the end of the word or the range
+			last := c | 31
+			if last > end {
+				last = end
+			}
+			lo, hi := uint(c&31), uint(last&31)
+			mask := ^uint32(0) >> (31 - hi) &^ (uint32(1)<<lo - 1)
+			l[word] |= mask
+			c = last + 1
+		}
+	}
+}
+
+// Contains whether the code point c is in the bitmap
+func (b Bitmap) Contains(c uint64) bool {
+	page := c >> 8
+	i := sort.Search(len(b.pages), func(i int) bool { return b.pages[i] >= page })
+	if i == len(b.pages) || b.pages[i] != page {
+		return false
+	}
+	return b.leaves[i][(c&0xff)>>5]&(1<<(c&31)) != 0
+}
+
+// Count the number of code points in the bitmap
+func (b Bitmap) Count() int {
+	n := 0
+	for _, l := range b.leaves {
+		for _, w := range l {
+			n += bits.OnesCount32(w)
+		}
+	}
+	return n
+}
+
+// Empty whether the bitmap has no code point
+func (b Bitmap) Empty() bool {
+	return len(b.pages) == 0
+}
+
+// Equal whether b and b1 hold the same code points
+func (b Bitmap) Equal(b1 Bitmap) bool {
+	if len(b.pages) != len(b1.pages) {
+		return false
+	}
+	for i := range b.pages {
+		if b.pages[i] != b1.pages[i] || b.leaves[i] != b1.leaves[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// appendLeaf append a leaf to the result of a set operation, dropping empty ones
+func (b *Bitmap) appendLeaf(page uint64, l leaf) {
+	if !l.empty() {
+		b.pages = append(b.pages, page)
+		b.leaves = append(b.leaves, l)
+	}
+}
+
+// merge walk the pages of b and b1 in order, op combines the leaves of a page,
+// a missing leaf is empty
+func (b Bitmap) merge(b1 Bitmap, op func(x, y uint32) uint32) Bitmap {
+	var out Bitmap
+	combine := func(x, y leaf) leaf {
+		var l leaf
+		for i := range l {
+			l[i] = op(x[i], y[i])
+		}
+		return l
+	}
+	i, j := 0, 0
+	for i < len(b.pages) || j < len(b1.pages) {
+		switch {
+		case j == len(b1.pages) || (i < len(b.pages) && b.pages[i] < b1.pages[j]):
+			out.appendLeaf(b.pages[i], combine(b.leaves[i], leaf{}))
+			i++
+		case i == len(b.pages) || b1.pages[j] < b.pages[i]:
+			out.appendLeaf(b1.pages[j], combine(leaf{}, b1.leaves[j]))
+			j++
+		default:
+			out.appendLeaf(b.pages[i], combine(b.leaves[i], b1.leaves[j]))
+			i++
+			j++
+		}
+	}
+	return out
+}
+
+// Union the code points in b or b1
+func (b Bitmap) Union(b1 Bitmap) Bitmap {
+	return b.merge(b1, func(x, y uint32) uint32 { return x | y })
+}
+
+// Intersect the code points both in b and b1
+func (b Bitmap) Intersect(b1 Bitmap) Bitmap {
+	return b.merge(b1, func(x, y uint32) uint32 { return x & y })
+}
+
+// Subtract the code points in b but not in b1
+func (b Bitmap) Subtract(b1 Bitmap) Bitmap {
+	return b.merge(b1, func(x, y uint32) uint32 { return x &^ y })
+}
+
+// Each call fn with every code point of the bitmap in ascending order, until fn returns false
+func (b Bitmap) Each(fn func(c uint64) bool) {
+	for i, l := range b.leaves {
+		for w, word := range l {
+			for word != 0 {
+				bit := uint64(bits.TrailingZeros32(word))
+				if !fn(b.pages[i]<<8 | uint64(w)<<5 | bit) {
+					return
+				}
+				word &= word - 1
+			}
+		}
+	}
+}
+
+// Charset the code points of the bitmap as sorted, merged ranges
+func (b Bitmap) Charset() Charset {
+	var c Charset
+	b.Each(func(cp uint64) bool {
+		if n := len(c); n > 0 && c[n-1].Max+1 == cp {
+			c[n-1].Max = cp
+			c[n-1].Len++
+			return true
+		}
+		c = append(c, CharsetRange{cp, cp, 1})
+		return true
+	})
+	return c
+}
@@ -0,0 +1,22 @@
+package charset
+
+import "testing"
+
+func TestAddRangeBeyondUnicode(t *testing.T) {
+	var b Bitmap
+	b.AddRange(0x10fff0, 0xffffffff)
+	b.Add(0x110000)
+	b.AddRange(0xffffffff, 0xffffffffffffffff)
+	if got := b.Charset().String(); got != "10fff0-10ffff " {
+		t.Errorf("got %q", got)
+	}
+	if n := (Charset{{0, 0xffffffff, 0x100000000}}).Count(); n != 0x110000 {
+		t.Errorf("Count() = %d", n)
+	}
+}
+
+func TestNewCharsetBeyondUnicode(t *testing.T) {
+	if got := NewCharset("41 110000 0-ffffffff 10fffe-110000 21ff-2190").String(); got != "41 " {
+		t.Errorf("got %q", got)
+	}
+}
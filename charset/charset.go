@@ -7,6 +7,9 @@ import (
 	"strings"
 )
 
+// MaxCodePoint the last Unicode code point, larger values are no characters
+const MaxCodePoint uint64 = 0x10FFFF
+
 // Charset collection of CharsetRange
 type Charset []CharsetRange
 
@@ -14,170 +17,91 @@ func (c Charset) Len() int {
 	return len(c)
 }
 
+// Less order the ranges by their first, then their last character
 func (c Charset) Less(i, j int) bool {
-	return c[i].Max < c[j].Min
+	if c[i].Min != c[j].Min {
+		return c[i].Min < c[j].Min
+	}
+	return c[i].Max < c[j].Max
 }
 
 func (c Charset) Swap(i, j int) {
 	c[i], c[j] = c[j], c[i]
 }
 
-// Append add c1 to c. ignore existing one
+// Append add c1 to c, merging it with the ranges it touches
 func (c *Charset) Append(c1 CharsetRange) {
-	found := false
-	for i, v := range *c {
-		if v.Min == c1.Min && v.Max == c1.Max {
-			found = true
-			break
-		}
-		if c1.Min-v.Max == 1 {
-			tmp := append((*c)[:i], CharsetRange{v.Min, c1.Max, int(c1.Max - v.Min + 1)})
-			*c = append(tmp, (*c)[i+1:]...)
-			found = true
-			break
-		}
-		if v.Min-c1.Max == 1 {
-			tmp := append((*c)[:i], CharsetRange{c1.Min, v.Max, int(v.Max - c1.Min + 1)})
-			*c = append(tmp, (*c)[i+1:]...)
-			found = true
-			break
+	n := len(*c)
+	// ranges are mostly appended in ascending order
+	if n == 0 || (*c)[n-1].Max+1 < c1.Min {
+		*c = append(*c, CharsetRange{c1.Min, c1.Max, int(c1.Max - c1.Min + 1)})
+		return
+	}
+	if last := (*c)[n-1]; last.Min <= c1.Min {
+		if c1.Max > last.Max {
+			(*c)[n-1] = CharsetRange{last.Min, c1.Max, int(c1.Max - last.Min + 1)}
 		}
+		return
 	}
-	if !found {
-		*c = append(*c, c1)
-	}
+	*c = c.Union(Charset{c1})
 }
 
-// Intersect common value both in c and c1
-func (c Charset) Intersect(c1 Charset) Charset {
-	c2 := Charset{}
+// normalized whether the ranges are sorted and neither overlap nor touch, like the Charsets of
+// NewCharset, Bitmap.Charset and the set operations
+func (c Charset) normalized() bool {
+	for i := 1; i < len(c); i++ {
+		if c[i-1].Max+1 >= c[i].Min {
+			return false
+		}
+	}
 	for _, v := range c {
-		for _, v1 := range c1 {
-			v2, ok := v.Intersect(v1)
-			if ok {
-				c2 = append(c2, v2)
-			}
+		if v.Min > v.Max {
+			return false
 		}
 	}
-	return c2
+	return true
 }
 
-// Union merge c and c1 to c2
-func (c Charset) Union(c1 Charset) (c4 Charset) {
-	c2 := c.Subtract(c1)
-	c3 := c1
-
-	for _, v := range c2 {
-		c3.Append(v)
-	}
+// Intersect common value both in c and c1. every set operation converts its operands to Bitmap,
+// combining many charsets is cheaper on their bitmaps.
+func (c Charset) Intersect(c1 Charset) Charset {
+	return c.Bitmap().Intersect(c1.Bitmap()).Charset()
+}
 
-	sort.Sort(c3)
+// Union merge c and c1 to c2
+func (c Charset) Union(c1 Charset) Charset {
+	return c.Bitmap().Union(c1.Bitmap()).Charset()
+}
 
-	for i := 0; i < len(c3); i++ {
-		if i == len(c3)-1 {
-			c4.Append(c3[i])
-			break
-		}
-		stop := c3[i].Max
-		jump := 0
-		for j := i + 1; j < len(c3); j++ {
-			if c3[j].Min-c3[j-1].Max == 1 {
-				stop = c3[j].Max
-				jump++
-			} else {
-				break
-			}
-		}
-		c4.Append(CharsetRange{c3[i].Min, stop, int(stop - c3[i].Min + 1)})
-		i += jump
-	}
+// Subtract subtract the CharsetRanges in c1 from c
+func (c Charset) Subtract(c1 Charset) Charset {
+	return c.Bitmap().Subtract(c1.Bitmap()).Charset()
+}
 
-	return c4
+// Contains whether the character r is in the Charset, which must be normalized like every Charset
+// this package returns
+func (c Charset) Contains(r uint64) bool {
+	i := sort.Search(len(c), func(i int) bool { return c[i].Max >= r })
+	return i < len(c) && c[i].Min <= r
 }
 
-// Subtract subtract the CharsetRanges in c1 from c
-func (c Charset) Subtract(c1 Charset) (c2 Charset) {
+// Count the number of characters in the Charset
+func (c Charset) Count() int {
+	if !c.normalized() {
+		return c.Bitmap().Count()
+	}
+	n := 0
 	for _, v := range c {
-		idx := 0
-		for i, v1 := range c1 {
-			val := v.Spaceship(v1)
-			switch val {
-			case 0, -0.5:
-				continue
-			case 1:
-				r := CharsetRange{v.Min, v1.Min - 1, int(v1.Min - v.Min)}
-				tc := Charset([]CharsetRange{r}).Subtract(c1[:i])
-				if len(tc) > 0 {
-					for _, v2 := range tc {
-						c2.Append(v2)
-					}
-				} else {
-					c2.Append(r)
-				}
-			case -1:
-				r := CharsetRange{v1.Max + 1, v.Max, int(v.Max - v1.Max)}
-				tc := Charset([]CharsetRange{r}).Subtract(c1[i+1:])
-				if len(tc) > 0 {
-					for _, v2 := range tc {
-						c2.Append(v2)
-					}
-				} else {
-					c2.Append(r)
-				}
-				// two value case
-			case 0.5:
-				if v.Min == v1.Min {
-					r := CharsetRange{v1.Max + 1, v.Max, int(v.Max - v1.Max)}
-					tc := Charset([]CharsetRange{r}).Subtract(c1[i+1:])
-					if len(tc) > 0 {
-						for _, v2 := range tc {
-							c2.Append(v2)
-						}
-					} else {
-						c2.Append(r)
-					}
-					continue
-				}
-				if v.Max == v1.Max {
-					r := CharsetRange{v.Min, v1.Min - 1, int(v1.Min - v.Min)}
-					tc := Charset([]CharsetRange{r}).Subtract(c1[:i])
-					if len(tc) > 0 {
-						for _, v2 := range tc {
-							c2.Append(v2)
-						}
-					} else {
-						c2.Append(r)
-					}
-					continue
-				}
-				r := CharsetRange{v1.Max + 1, v.Max, int(v.Max - v1.Max)}
-				tc := Charset([]CharsetRange{r}).Subtract(c1[i+1:])
-				if len(tc) > 0 {
-					for _, v2 := range tc {
-						c2.Append(v2)
-					}
-				} else {
-					c2.Append(r)
-				}
-				r1 := CharsetRange{v.Min, v1.Min - 1, int(v1.Min - v.Min)}
-				tc1 := Charset([]CharsetRange{r1}).Subtract(c1[:i])
-				if len(tc1) > 0 {
-					for _, v2 := range tc1 {
-						c2.Append(v2)
-					}
-				} else {
-					c2.Append(r1)
-				}
-			default:
-				idx++
-			}
-			if idx == len(c1) {
-				c2.Append(v)
-			}
+		if v.Min > MaxCodePoint {
+			break
 		}
+		max := v.Max
+		if max > MaxCodePoint {
+			max = MaxCodePoint
+		}
+		n += int(max - v.Min + 1)
 	}
-	sort.Sort(c2)
-	return c2
+	return n
 }
 
 // String echo the Charset as string
@@ -255,18 +179,26 @@ func (c CharsetRange) Intersect(c1 CharsetRange) (CharsetRange, bool) {
 	return CharsetRange{min, max, len}, true
 }
 
-// NewCharset initialize a Charset from string
+// NewCharset initialize a normalized Charset from string, tokens that are no hexadecimal code point
+// or range, ranges beyond MaxCodePoint and ranges in descending order are skipped
 func NewCharset(in string) (charset Charset) {
-	for _, i := range strings.Split(in, " ") {
-		if strings.Contains(i, "-") {
-			arr := strings.Split(i, "-")
-			a, _ := strconv.ParseUint(arr[0], 16, 32)
-			b, _ := strconv.ParseUint(arr[1], 16, 32)
-			charset = append(charset, CharsetRange{a, b, int(b - a + 1)})
+	for _, i := range strings.Fields(in) {
+		arr := strings.Split(i, "-")
+		if len(arr) > 2 {
+			continue
+		}
+		a, err := strconv.ParseUint(arr[0], 16, 32)
+		if err != nil {
+			continue
+		}
+		b, err := strconv.ParseUint(arr[len(arr)-1], 16, 32)
+		if err != nil || a > b || b > MaxCodePoint {
 			continue
 		}
-		j, _ := strconv.ParseUint(i, 16, 32)
-		charset = append(charset, CharsetRange{j, j, 1})
+		charset = append(charset, CharsetRange{a, b, int(b - a + 1)})
+	}
+	if !charset.normalized() {
+		charset = charset.Union(nil)
 	}
 	return charset
 }
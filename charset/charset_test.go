@@ -0,0 +1,171 @@
+package charset
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+)
+
+// universe the code points the De Morgan laws are checked over
+var universe = Charset{{0, 0x10ffff, 0x110000}}
+
+func quickCheck(t *testing.T, f interface{}) {
+	t.Helper()
+	if err := quick.Check(f, &quick.Config{MaxCount: 500, Rand: rand.New(rand.NewSource(1))}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUnionContainsOperands(t *testing.T) {
+	quickCheck(t, func(a, b Charset) bool {
+		u := a.Union(b)
+		return len(a.Subtract(u)) == 0 && len(b.Subtract(u)) == 0
+	})
+}
+
+func TestSubtractDisjoint(t *testing.T) {
+	quickCheck(t, func(a, b Charset) bool {
+		return len(a.Subtract(b).Intersect(b)) == 0
+	})
+}
+
+func TestIntersectCommutes(t *testing.T) {
+	quickCheck(t, func(a, b Charset) bool {
+		return a.Intersect(b).Bitmap().Equal(b.Intersect(a).Bitmap())
+	})
+}
+
+func TestUnionCommutes(t *testing.T) {
+	quickCheck(t, func(a, b Charset) bool {
+		return a.Union(b).Bitmap().Equal(b.Union(a).Bitmap())
+	})
+}
+
+func TestDeMorgan(t *testing.T) {
+	u := universe.Bitmap()
+	quickCheck(t, func(a, b Charset) bool {
+		x, y := a.Bitmap(), b.Bitmap()
+		notX, notY := u.Subtract(x), u.Subtract(y)
+		return u.Subtract(x.Union(y)).Equal(notX.Intersect(notY)) &&
+			u.Subtract(x.Intersect(y)).Equal(notX.Union(notY))
+	})
+}
+
+func TestCountInclusionExclusion(t *testing.T) {
+	quickCheck(t, func(a, b Charset) bool {
+		return a.Union(b).Count()+a.Intersect(b).Count() == a.Count()+b.Count()
+	})
+}
+
+func TestSetOperationsMatchReference(t *testing.T) {
+	quickCheck(t, func(a, b Charset) bool {
+		ra, rb := refSetOf(a), refSetOf(b)
+		for _, msg := range []string{
+			checkCharset(a.Union(b), ra.union(rb)),
+			checkCharset(a.Intersect(b), ra.intersect(rb)),
+			checkCharset(a.Subtract(b), ra.subtract(rb)),
+		} {
+			if len(msg) > 0 {
+				t.Log(msg)
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func TestAppend(t *testing.T) {
+	quickCheck(t, func(a Charset) bool {
+		var c Charset
+		for _, r := range a {
+			c.Append(r)
+		}
+		if msg := checkCharset(c, refSetOf(a)); len(msg) > 0 {
+			t.Log(msg)
+			return false
+		}
+		return true
+	})
+}
+
+func TestContains(t *testing.T) {
+	quickCheck(t, func(a Charset, probes []uint16) bool {
+		// Contains needs the normalized form
+		ra, b, n := refSetOf(a), a.Bitmap(), a.Union(nil)
+		for _, r := range a {
+			for _, cp := range []uint64{r.Min, r.Max, r.Min - 1, r.Max + 1} {
+				if n.Contains(cp) != ra[cp] || b.Contains(cp) != ra[cp] {
+					return false
+				}
+			}
+		}
+		for _, p := range probes {
+			if b.Contains(uint64(p)) != ra[uint64(p)] || n.Contains(uint64(p)) != ra[uint64(p)] {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func TestEachAscending(t *testing.T) {
+	quickCheck(t, func(a Charset) bool {
+		n, prev, ok := 0, uint64(0), true
+		a.Bitmap().Each(func(c uint64) bool {
+			if n > 0 && c <= prev {
+				ok = false
+			}
+			n, prev = n+1, c
+			return true
+		})
+		return ok && n == len(refSetOf(a))
+	})
+}
+
+func TestStringRoundTrip(t *testing.T) {
+	quickCheck(t, func(a Charset) bool {
+		c := a.Union(nil)
+		return NewCharset(c.String()).Bitmap().Equal(c.Bitmap()) &&
+			NewCharset(c.String()).String() == c.String()
+	})
+}
+
+func TestNewCharset(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"", ""},
+		{"20-7e", "20-7e "},
+		{"a0 ad", "a0 ad "},
+		{" 1f300-1f5ff  1f900-1f9ff ", "1f300-1f5ff 1f900-1f9ff "},
+		{"10ffff", "10ffff "},
+		// normalized
+		{"41-5a 30-39 50-60 7b", "30-39 41-60 7b "},
+		// no code points
+		{"zz - 41- -41 1-2-3 0x41 +41 42", "42 "},
+	}
+	for _, tt := range tests {
+		if got := NewCharset(tt.in).String(); got != tt.want {
+			t.Errorf("NewCharset(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCount(t *testing.T) {
+	quickCheck(t, func(a Charset) bool {
+		return a.Count() == len(refSetOf(a)) && a.Union(nil).Count() == len(refSetOf(a))
+	})
+}
+
+func TestAddRangeAcrossPages(t *testing.T) {
+	var b Bitmap
+	b.AddRange(0xfe, 0x301)
+	b.Add(0x10ffff)
+	if got := b.Charset().String(); got != "fe-301 10ffff " {
+		t.Errorf("got %q", got)
+	}
+	if b.Count() != 0x301-0xfe+2 {
+		t.Errorf("Count() = %d", b.Count())
+	}
+}
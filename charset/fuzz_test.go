@@ -0,0 +1,79 @@
+package charset
+
+import (
+	"strings"
+	"testing"
+)
+
+// fontconfig charset strings, as found in fonts.conf and in the fc-query output
+var fuzzSeeds = []string{
+	"",
+	"20-7e",
+	"20-7e a0-17f 2000-206f",
+	"a9 ae 203c 2049 2122 2139 2194-2199",
+	"1f300-1f5ff 1f600-1f64f 1f900-1f9ff",
+	"0-ff 100-1ff",
+	"10ffff",
+	" 41  42-44 ",
+	"zz 41 - 42-",
+	"1-2-3 0x41 +41 -41",
+}
+
+func FuzzNewCharset(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, in string) {
+		c := NewCharset(in)
+		want, ok := refParse(in)
+		if !ok {
+			// a rejected token adds no code point, the others are parsed like without it
+			var valid []string
+			for _, field := range strings.Fields(in) {
+				if _, _, ok := refToken(field); ok {
+					valid = append(valid, field)
+					continue
+				}
+				if n := NewCharset(field).Count(); n > 0 {
+					t.Fatalf("NewCharset(%q) has %d code points", field, n)
+				}
+			}
+			if !c.Bitmap().Equal(NewCharset(strings.Join(valid, " ")).Bitmap()) {
+				t.Fatalf("NewCharset(%q) = %s, want %s", in, c, NewCharset(strings.Join(valid, " ")))
+			}
+			return
+		}
+		if msg := checkCharset(c.Union(nil), want); len(msg) > 0 {
+			t.Fatalf("NewCharset(%q): %s", in, msg)
+		}
+		if c.Count() != len(want) {
+			t.Fatalf("NewCharset(%q).Count() = %d, want %d", in, c.Count(), len(want))
+		}
+	})
+}
+
+func FuzzSetOperations(f *testing.F) {
+	for i := range fuzzSeeds {
+		f.Add(fuzzSeeds[i], fuzzSeeds[len(fuzzSeeds)-1-i])
+	}
+	f.Fuzz(func(t *testing.T, x, y string) {
+		rx, ok := refParse(x)
+		if !ok {
+			return
+		}
+		ry, ok := refParse(y)
+		if !ok {
+			return
+		}
+		a, b := NewCharset(x), NewCharset(y)
+		for op, msg := range map[string]string{
+			"Union":     checkCharset(a.Union(b), rx.union(ry)),
+			"Intersect": checkCharset(a.Intersect(b), rx.intersect(ry)),
+			"Subtract":  checkCharset(a.Subtract(b), rx.subtract(ry)),
+		} {
+			if len(msg) > 0 {
+				t.Fatalf("%q %s %q: %s", x, op, y, msg)
+			}
+		}
+	})
+}
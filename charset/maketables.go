@@ -0,0 +1,166 @@
+//go:build ignore
+// +build ignore
+
+// maketables generate tables.go from the Unicode Character Database:
+// Scripts.txt, Blocks.txt and emoji/emoji-data.txt
+//
+//	go run maketables.go -ucd https://www.unicode.org/Public/14.0.0/ucd -output tables.go
+//
+// -ucd may also be a local copy of the database, it defaults to $UCD.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	ucd    = flag.String("ucd", os.Getenv("UCD"), "URL or directory of the Unicode Character Database")
+	output = flag.String("output", "tables.go", "the generated file")
+)
+
+// codeRange the code points from lo to hi, inclusive
+type codeRange struct {
+	lo, hi uint64
+}
+
+// open open a file of the database
+func open(name string) (io.ReadCloser, error) {
+	if strings.HasPrefix(*ucd, "http://") || strings.HasPrefix(*ucd, "https://") {
+		url := strings.TrimSuffix(*ucd, "/") + "/" + name
+		resp, err := http.Get(url)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("can not get %s: %s", url, resp.Status)
+		}
+		return resp.Body, nil
+	}
+	return os.Open(filepath.Join(*ucd, name))
+}
+
+// parse read the "lo..hi ; value # comment" lines of a database file into the ranges per value,
+// the first comment line goes to header
+func parse(name string) (ranges map[string][]codeRange, header string) {
+	f, err := open(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	ranges = make(map[string][]codeRange)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if len(header) == 0 && strings.HasPrefix(line, "#") {
+			header = line
+		}
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Split(line, ";")
+		if len(fields) != 2 {
+			continue
+		}
+		bounds := strings.Split(strings.TrimSpace(fields[0]), "..")
+		lo, err := strconv.ParseUint(bounds[0], 16, 32)
+		if err != nil {
+			log.Fatalf("%s: invalid code point in %q", name, line)
+		}
+		hi, err := strconv.ParseUint(bounds[len(bounds)-1], 16, 32)
+		if err != nil {
+			log.Fatalf("%s: invalid code point in %q", name, line)
+		}
+		value := strings.TrimSpace(fields[1])
+		ranges[value] = append(ranges[value], codeRange{lo, hi})
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	return ranges, header
+}
+
+// charsetString sort and merge the ranges, and format them like charset.Charset.String
+func charsetString(ranges []codeRange) string {
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].lo < ranges[j].lo })
+	var merged []codeRange
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && merged[n-1].hi+1 >= r.lo {
+			if r.hi > merged[n-1].hi {
+				merged[n-1].hi = r.hi
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	var s []string
+	for _, r := range merged {
+		if r.lo == r.hi {
+			s = append(s, strconv.FormatUint(r.lo, 16))
+			continue
+		}
+		s = append(s, strconv.FormatUint(r.lo, 16)+"-"+strconv.FormatUint(r.hi, 16))
+	}
+	return strings.Join(s, " ")
+}
+
+// writeTable write the ranges as a map from name to charset string
+func writeTable(buf *bytes.Buffer, name, doc string, ranges map[string][]codeRange) {
+	var keys []string
+	for k := range ranges {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	fmt.Fprintf(buf, "// %s %s\nvar %s = map[string]string{\n", name, doc, name)
+	for _, k := range keys {
+		fmt.Fprintf(buf, "%q: %q,\n", k, charsetString(ranges[k]))
+	}
+	fmt.Fprintf(buf, "}\n\n")
+}
+
+func main() {
+	flag.Parse()
+	if len(*ucd) == 0 {
+		log.Fatal("no Unicode Character Database, use -ucd or $UCD")
+	}
+
+	scripts, _ := parse("Scripts.txt")
+	blocks, header := parse("Blocks.txt")
+	properties, _ := parse("emoji/emoji-data.txt")
+
+	version := regexp.MustCompile(`\d+\.\d+\.\d+`).FindString(header)
+	if len(version) == 0 {
+		log.Fatalf("no Unicode version in the header of Blocks.txt: %q", header)
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by \"go run maketables.go\"; DO NOT EDIT.\n\npackage charset\n\n")
+	fmt.Fprintf(buf, "// UnicodeVersion the version of the Unicode Character Database the tables are built from\n")
+	fmt.Fprintf(buf, "const UnicodeVersion = %q\n\n", version)
+	writeTable(buf, "scriptTable", "the characters of the Unicode scripts, from Scripts.txt", scripts)
+	writeTable(buf, "blockTable", "the characters of the Unicode blocks, from Blocks.txt", blocks)
+	writeTable(buf, "propertyTable", "the characters of the emoji properties, from emoji-data.txt", properties)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatalf("can not write %s: %s", *output, err)
+	}
+}
@@ -0,0 +1,145 @@
+package charset
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// refSet the naive reference implementation: a set of code points
+type refSet map[uint64]bool
+
+func (s refSet) union(s1 refSet) refSet {
+	out := refSet{}
+	for c := range s {
+		out[c] = true
+	}
+	for c := range s1 {
+		out[c] = true
+	}
+	return out
+}
+
+func (s refSet) intersect(s1 refSet) refSet {
+	out := refSet{}
+	for c := range s {
+		if s1[c] {
+			out[c] = true
+		}
+	}
+	return out
+}
+
+func (s refSet) subtract(s1 refSet) refSet {
+	out := refSet{}
+	for c := range s {
+		if !s1[c] {
+			out[c] = true
+		}
+	}
+	return out
+}
+
+// refSetOf the code points of a Charset, range by range
+func refSetOf(c Charset) refSet {
+	s := refSet{}
+	for _, r := range c {
+		for cp := r.Min; cp <= r.Max; cp++ {
+			s[cp] = true
+		}
+	}
+	return s
+}
+
+// refToken parse a token of a fontconfig charset string the naive way, ok is false for anything
+// that is no hexadecimal code point or range of them
+func refToken(field string) (min, max uint64, ok bool) {
+	bounds := strings.Split(field, "-")
+	if len(bounds) > 2 {
+		return 0, 0, false
+	}
+	var cps []uint64
+	for _, b := range bounds {
+		cp, err := strconv.ParseUint(b, 16, 32)
+		if err != nil {
+			return 0, 0, false
+		}
+		cps = append(cps, cp)
+	}
+	return cps[0], cps[len(cps)-1], true
+}
+
+// refParse parse a fontconfig charset string the naive way, ok is false for
+// anything NewCharset is not expected to understand
+func refParse(in string) (s refSet, ok bool) {
+	s = refSet{}
+	for _, field := range strings.Fields(in) {
+		min, max, ok := refToken(field)
+		if !ok {
+			return nil, false
+		}
+		// no characters
+		if min > max || max > MaxCodePoint {
+			continue
+		}
+		// keep the reference cheap
+		if max-min > 1<<16 {
+			return nil, false
+		}
+		for cp := min; cp <= max; cp++ {
+			s[cp] = true
+		}
+	}
+	return s, true
+}
+
+// checkCharset whether c holds exactly the code points of s as sorted, merged ranges
+func checkCharset(c Charset, s refSet) string {
+	var got []uint64
+	for i, r := range c {
+		if r.Min > r.Max || r.Len != int(r.Max-r.Min+1) {
+			return "malformed range " + Charset{r}.String()
+		}
+		if i > 0 && c[i-1].Max+1 >= r.Min {
+			return "unmerged ranges " + c[i-1:i+1].String()
+		}
+		for cp := r.Min; cp <= r.Max; cp++ {
+			got = append(got, cp)
+		}
+	}
+	var want []uint64
+	for cp := range s {
+		want = append(want, cp)
+	}
+	sort.Slice(want, func(i, j int) bool { return want[i] < want[j] })
+	if len(got) != len(want) || (len(got) > 0 && !reflect.DeepEqual(got, want)) {
+		return "got " + c.String() + "want " + strconv.Itoa(len(want)) + " code points"
+	}
+	return ""
+}
+
+// randomCharset a Charset of random, possibly overlapping and unsorted ranges
+// around page boundaries and in the supplementary planes
+func randomCharset(r *rand.Rand) Charset {
+	bases := []uint64{0, 0xf0, 0x2000, 0x1f300, 0x10ff00}
+	var c Charset
+	for i := r.Intn(12); i > 0; i-- {
+		min := bases[r.Intn(len(bases))] + uint64(r.Intn(0x300))
+		if min > 0x10ffff {
+			min = 0x10ffff
+		}
+		max := min + uint64(r.Intn(0x120))
+		if max > 0x10ffff {
+			max = 0x10ffff
+		}
+		c = append(c, CharsetRange{min, max, int(max - min + 1)})
+	}
+	return c
+}
+
+// Generate implement quick.Generator
+func (Charset) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(randomCharset(r))
+}
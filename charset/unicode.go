@@ -0,0 +1,145 @@
+package charset
+
+//go:generate go run maketables.go -output tables.go
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// lazyTable a generated table, its charset strings are only parsed when it is used first
+type lazyTable struct {
+	once     sync.Once
+	table    map[string]string
+	charsets map[string]Charset
+}
+
+// get the parsed table
+func (t *lazyTable) get() map[string]Charset {
+	t.once.Do(func() {
+		t.charsets = make(map[string]Charset, len(t.table))
+		for name, chars := range t.table {
+			t.charsets[name] = NewCharset(chars)
+		}
+	})
+	return t.charsets
+}
+
+var (
+	scripts    = &lazyTable{table: scriptTable}
+	blocks     = &lazyTable{table: blockTable}
+	properties = &lazyTable{table: propertyTable}
+
+	scriptBitmapsOnce sync.Once
+	scriptBitmapTable map[string]Bitmap
+)
+
+// scriptBitmaps the scripts for Coverage, built on first use
+func scriptBitmaps() map[string]Bitmap {
+	scriptBitmapsOnce.Do(func() {
+		scriptBitmapTable = make(map[string]Bitmap, len(scriptTable))
+		for name, c := range scripts.get() {
+			scriptBitmapTable[name] = c.Bitmap()
+		}
+	})
+	return scriptBitmapTable
+}
+
+// looseKey match Unicode names loosely, like UAX #44 does: ignoring case, blanks, "_" and "-",
+// so "Basic Latin", "basic_latin" and "BasicLatin" are the same block
+func looseKey(name string) string {
+	return strings.NewReplacer(" ", "", "_", "", "-", "").Replace(strings.ToLower(name))
+}
+
+// lookup find name in the table, matching it loosely
+func lookup(table map[string]Charset, name string) (Charset, bool) {
+	if c, ok := table[name]; ok {
+		return c, true
+	}
+	key := looseKey(name)
+	for k, c := range table {
+		if looseKey(k) == key {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// Script the characters of the Unicode script named like "Han", "Arabic" or "Old_Italic"
+func Script(name string) (Charset, bool) {
+	return lookup(scripts.get(), name)
+}
+
+// Block the characters of the Unicode block named like "Basic Latin" or "CJK Unified Ideographs"
+func Block(name string) (Charset, bool) {
+	return lookup(blocks.get(), name)
+}
+
+// Property the characters with the Unicode emoji property: Emoji, Emoji_Presentation,
+// Emoji_Modifier, Emoji_Modifier_Base, Emoji_Component or Extended_Pictographic
+func Property(name string) (Charset, bool) {
+	return lookup(properties.get(), name)
+}
+
+// names the sorted names of a table
+func names(table map[string]Charset) []string {
+	var s []string
+	for name := range table {
+		s = append(s, name)
+	}
+	sort.Strings(s)
+	return s
+}
+
+// Scripts the names of the Unicode scripts, sorted
+func Scripts() []string {
+	return names(scripts.get())
+}
+
+// Blocks the names of the Unicode blocks, in code point order
+func Blocks() []string {
+	table := blocks.get()
+	s := names(table)
+	sort.Slice(s, func(i, j int) bool { return table[s[i]][0].Min < table[s[j]][0].Min })
+	return s
+}
+
+// ScriptCoverage how many characters of a Unicode script a charset has
+type ScriptCoverage struct {
+	Script  string
+	Covered int
+	Total   int
+}
+
+// Ratio the covered part of the script, from 0 to 1
+func (s ScriptCoverage) Ratio() float64 {
+	if s.Total == 0 {
+		return 0
+	}
+	return float64(s.Covered) / float64(s.Total)
+}
+
+// String echo the coverage like "Latin 12.3% (163/1475)"
+func (s ScriptCoverage) String() string {
+	return fmt.Sprintf("%s %.1f%% (%d/%d)", s.Script, 100*s.Ratio(), s.Covered, s.Total)
+}
+
+// Coverage the scripts c has characters of, the best covered first
+func (c Charset) Coverage() []ScriptCoverage {
+	b := c.Bitmap()
+	var cov []ScriptCoverage
+	for name, script := range scriptBitmaps() {
+		if n := b.Intersect(script).Count(); n > 0 {
+			cov = append(cov, ScriptCoverage{name, n, script.Count()})
+		}
+	}
+	sort.Slice(cov, func(i, j int) bool {
+		if cov[i].Ratio() != cov[j].Ratio() {
+			return cov[i].Ratio() > cov[j].Ratio()
+		}
+		return cov[i].Script < cov[j].Script
+	})
+	return cov
+}
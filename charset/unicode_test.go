@@ -0,0 +1,85 @@
+package charset
+
+import "testing"
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		kind string
+		fn   func(string) (Charset, bool)
+		name string
+		in   []uint64
+		out  []uint64
+	}{
+		{"Script", Script, "Latin", []uint64{0x41, 0x7a, 0xe9, 0x1e9e}, []uint64{0x30, 0x3b1, 0x4e00}},
+		{"Script", Script, "Han", []uint64{0x4e00, 0x9fa5, 0x3005}, []uint64{0x41, 0x3042}},
+		{"Script", Script, "old italic", []uint64{0x10300}, []uint64{0x41}},
+		{"Block", Block, "Basic Latin", []uint64{0, 0x41, 0x7f}, []uint64{0x80, 0xe9}},
+		{"Block", Block, "basic_latin", []uint64{0, 0x7f}, []uint64{0x80}},
+		{"Block", Block, "CJK Unified Ideographs", []uint64{0x4e00, 0x9fff}, []uint64{0x3400}},
+		{"Property", Property, "Emoji", []uint64{0x23, 0xa9, 0x1f600}, []uint64{0x41, 0x4e00}},
+		{"Property", Property, "Emoji_Presentation", []uint64{0x231a, 0x1f600}, []uint64{0x23, 0xa9}},
+		{"Property", Property, "Extended_Pictographic", []uint64{0xa9, 0x1f600}, []uint64{0x23}},
+	}
+	for _, tt := range tests {
+		c, ok := tt.fn(tt.name)
+		if !ok {
+			t.Errorf("%s(%q) not found", tt.kind, tt.name)
+			continue
+		}
+		for _, r := range tt.in {
+			if !c.Contains(r) {
+				t.Errorf("%s(%q) misses %x", tt.kind, tt.name, r)
+			}
+		}
+		for _, r := range tt.out {
+			if c.Contains(r) {
+				t.Errorf("%s(%q) has %x", tt.kind, tt.name, r)
+			}
+		}
+	}
+	if c, _ := Block("BasicLatin"); c.String() != "0-7f " {
+		t.Errorf("Block(\"BasicLatin\") = %q, want \"0-7f \"", c.String())
+	}
+	for kind, fn := range map[string]func(string) (Charset, bool){"Script": Script, "Block": Block, "Property": Property} {
+		if _, ok := fn("Klingon"); ok {
+			t.Errorf("%s(\"Klingon\") found", kind)
+		}
+	}
+}
+
+func TestBlocksInCodePointOrder(t *testing.T) {
+	b := Blocks()
+	if len(b) < 2 || b[0] != "Basic Latin" || b[1] != "Latin-1 Supplement" {
+		t.Errorf("Blocks() starts with %v, want [Basic Latin Latin-1 Supplement]", b[:2])
+	}
+}
+
+func TestCoverage(t *testing.T) {
+	latin, _ := Script("Latin")
+	greek, _ := Script("Greek")
+	// the 26 capital Latin letters, the 25 small Greek ones and digits of the Common script
+	cov := NewCharset("30-39 41-5a 3b1-3c9").Coverage()
+	want := map[string]ScriptCoverage{
+		"Latin": {"Latin", 26, latin.Count()},
+		"Greek": {"Greek", 25, greek.Count()},
+	}
+	for _, s := range cov {
+		if w, ok := want[s.Script]; ok {
+			if s != w {
+				t.Errorf("coverage %v, want %v", s, w)
+			}
+			delete(want, s.Script)
+		}
+	}
+	for _, w := range want {
+		t.Errorf("no coverage of %s", w.Script)
+	}
+	for i := 1; i < len(cov); i++ {
+		if cov[i].Ratio() > cov[i-1].Ratio() {
+			t.Errorf("%v sorted after %v", cov[i], cov[i-1])
+		}
+	}
+	if cov := NewCharset("").Coverage(); len(cov) != 0 {
+		t.Errorf("empty charset covers %v", cov)
+	}
+}
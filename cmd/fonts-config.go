@@ -6,14 +6,14 @@ import (
 	"os"
 	"os/user"
 	"path/filepath"
-	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/marguerite/fonts-config-ng/font"
 	"github.com/marguerite/fonts-config-ng/lib"
 	"github.com/marguerite/fonts-config-ng/sysconfig"
 	"github.com/marguerite/go-stdlib/dir"
-	"github.com/marguerite/go-stdlib/ioutils"
 	"github.com/marguerite/go-stdlib/slice"
 	"github.com/urfave/cli"
 )
@@ -21,16 +21,21 @@ import (
 // VERSION fonts-config's version
 const VERSION string = "20201005"
 
-func rmUserFcConfig(userMode bool) {
+// rmUserFcConfig remove the fontconfig files of the user through w, except fonts.conf
+func rmUserFcConfig(w lib.Writer, userMode bool) error {
 	if !userMode {
-		return
+		return nil
 	}
-	path := filepath.Join(os.Getenv("HOME"), ".config/fontconfig")
+	path := lib.UserFcConfigDir()
 	cfgs, _ := dir.Glob(path, "\\.conf$")
 	slice.Remove(&cfgs, filepath.Join(path, "fonts.conf"))
 	for _, f := range cfgs {
-		os.Remove(f)
+		err := w.WriteFile(f, nil)
+		if err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
 func yastInfo() {
@@ -51,7 +56,248 @@ func yastInfo() {
 		"  user rendering config: fontconfig/rendering-options.conf\n")
 }
 
-func main() {
+// chkPermission exit if system files would be touched without root permissions
+func chkPermission(userMode bool) {
+	currentUser, _ := user.Current()
+	if !userMode && lib.Root() == "/" && currentUser.Uid != "0" && currentUser.Username != "root" {
+		log.Fatal("*** error: no root permissions; rerun with --user for user fontconfig setting.")
+	}
+}
+
+// parseVerbosity parse verbosity from the global options
+func parseVerbosity(c *cli.Context) int {
+	verbosity := 0
+	if c.Bool("d") {
+		verbosity = 256
+	}
+	if c.Bool("v") {
+		verbosity = 1
+	}
+	return verbosity
+}
+
+// loadSchema parse the types and defaults of the settings from the sysconfig template
+func loadSchema() (sysconfig.Schema, error) {
+	return sysconfig.ParseSchemaFile(lib.RootPath(lib.SysconfigTemplate))
+}
+
+// envPrefix the prefix of environment variables overriding settings, eg. FONTS_CONFIG_USE_RGBA
+const envPrefix string = "FONTS_CONFIG_"
+
+// loadLayers read the settings from every layer, in order: the template defaults, /etc/sysconfig/fonts-config,
+// the user's settings file in user mode, FONTS_CONFIG_* environment variables and cli args
+func loadLayers(c *cli.Context, schema sysconfig.Schema, verbosity int) ([]sysconfig.Layer, error) {
+	layers := []sysconfig.Layer{{Origin: "default", Config: schema.Defaults()}}
+
+	files := []string{lib.SettingsFile(false)}
+	if c.Bool("u") {
+		files = append(files, lib.SettingsFile(true))
+	}
+	for _, path := range files {
+		f, err := os.Open(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		cfg := make(sysconfig.Config)
+		cfg.Unmarshal(f)
+		f.Close()
+		layers = append(layers, sysconfig.Layer{Origin: "file:" + path, Config: cfg})
+	}
+
+	env := make(sysconfig.Config)
+	flags := make(sysconfig.Config)
+	if c.Bool("v") || c.Bool("d") {
+		flags["VERBOSITY"] = strconv.Itoa(verbosity)
+	}
+	for _, o := range schema {
+		if v, ok := os.LookupEnv(envPrefix + o.Key); ok {
+			env[o.Key] = v
+		}
+		flag := strings.ReplaceAll(strings.ToLower(o.Key), "_", "-")
+		if c.IsSet(flag) {
+			if o.Kind == sysconfig.YesNo {
+				flags[o.Key] = "no"
+				if c.Bool(flag) {
+					flags[o.Key] = "yes"
+				}
+				continue
+			}
+			flags[o.Key] = c.String(flag)
+		}
+	}
+
+	return append(layers, sysconfig.Layer{Origin: "env", Config: env}, sysconfig.Layer{Origin: "command line", Config: flags}), nil
+}
+
+// loadConfig merge the settings of every layer and validate the result against the types
+// declared in the sysconfig template
+func loadConfig(c *cli.Context, verbosity int) (sysconfig.Config, sysconfig.Settings, error) {
+	schema, err := loadSchema()
+	if err != nil {
+		return nil, sysconfig.Settings{}, err
+	}
+	layers, err := loadLayers(c, schema, verbosity)
+	if err != nil {
+		return nil, sysconfig.Settings{}, err
+	}
+	cfg, _ := sysconfig.Merge(layers...)
+
+	settings, err := schema.Settings(cfg)
+	if err != nil {
+		return cfg, settings, fmt.Errorf("*** error: invalid configuration:\n%s", err.Error())
+	}
+	return cfg, settings, nil
+}
+
+// generator a step of generate and the settings its output depends on
+type generator struct {
+	keys []string
+	gen  func(w lib.Writer) error
+}
+
+// generators the steps generating every fontconfig file, fonts are only scanned if a step needs them.
+// readOnly leaves the font cache alone, for the commands only previewing the output.
+func generators(settings sysconfig.Settings, userMode, readOnly bool) []generator {
+	var collection font.Collection
+	fonts := func() font.Collection {
+		if collection == nil {
+			if readOnly {
+				collection = font.ReadCollection(lib.Root(), userMode)
+			} else {
+				collection = font.LoadCollection(lib.Root(), userMode)
+			}
+		}
+		return collection
+	}
+
+	var gens []generator
+	if !userMode {
+		gens = append(gens, generator{nil, func(w lib.Writer) error { return lib.GenMetricCompatibility(w, settings.Verbosity) }})
+	}
+
+	/*	# The following calls may change files in /etc/fonts, therefore
+		# they have to be called *before* fc-cache. If anything is
+		# changed in /etc/fonts after calling fc-cache, fontconfig
+		# will think that the cache files are out of date again. */
+
+	gens = append(gens,
+		generator{[]string{"FORCE_HINTSTYLE", "FORCE_AUTOHINT", "FORCE_BW", "FORCE_BW_MONOSPACE", "USE_LCDFILTER", "USE_RGBA",
+			"USE_EMBEDDED_BITMAPS", "EMBEDDED_BITMAPS_LANGUAGES", "SEARCH_METRIC_COMPATIBLE"},
+			func(w lib.Writer) error { return lib.GenRenderingOptions(w, userMode, settings) }},
+		generator{[]string{"PREFER_SANS_FAMILIES", "PREFER_SERIF_FAMILIES", "PREFER_MONO_FAMILIES", "FORCE_FAMILY_PREFERENCE_LISTS"},
+			func(w lib.Writer) error { return lib.GenFamilyPreferenceLists(w, userMode, settings) }},
+		generator{[]string{"EMOJI_BLACKLIST", "PREFER_EMOJI_FAMILY", "EMOJI_BLACKLIST_ALLOWLIST", "EMOJI_BLACKLIST_KEEP_RANGES", "EMOJI_PRESENTATION",
+			"EMOJI_CHARSET"},
+			func(w lib.Writer) error { return lib.GenEmojiBlacklist(w, fonts(), userMode, settings) }},
+		generator{nil, func(w lib.Writer) error { return lib.GenNotoConfig(w, fonts(), userMode) }},
+		generator{nil, func(w lib.Writer) error { return lib.GenCJKConfig(w, fonts(), userMode) }},
+	)
+
+	if !userMode && settings.GenerateJavaFontSetup {
+		gens = append(gens, generator{[]string{"GENERATE_JAVA_FONT_SETUP"},
+			func(w lib.Writer) error { return lib.GenerateJavaFontSetup(w, fonts(), settings.Verbosity) }})
+	}
+	return gens
+}
+
+// generate render every fontconfig file through w, stop at the first generator failing
+func generate(w lib.Writer, settings sysconfig.Settings, userMode, readOnly bool) error {
+	for _, g := range generators(settings, userMode, readOnly) {
+		err := g.gen(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// regenerate render only the fontconfig files depending on the setting key through w.
+// returns whether anything was regenerated.
+func regenerate(w lib.Writer, settings sysconfig.Settings, userMode bool, key string) (bool, error) {
+	affected := false
+	for _, g := range generators(settings, userMode, false) {
+		if ok, _ := slice.Contains(g.keys, key); !ok {
+			continue
+		}
+		affected = true
+		err := g.gen(w)
+		if err != nil {
+			return affected, err
+		}
+	}
+	return affected, nil
+}
+
+// updateSetting persist key=value to the settings file and regenerate the outputs depending on it,
+// everything is rolled back if any step fails
+func updateSetting(c *cli.Context, key, value string) error {
+	userMode := c.Bool("u")
+	chkPermission(userMode)
+	verbosity := parseVerbosity(c)
+
+	schema, err := loadSchema()
+	if err != nil {
+		return cli.NewExitError(err.Error(), 1)
+	}
+	o, ok := schema.Lookup(key)
+	if !ok {
+		return cli.NewExitError(fmt.Sprintf("unknown setting %s", key), 1)
+	}
+	err = o.Validate(value)
+	if err != nil {
+		return cli.NewExitError(err.Error(), 1)
+	}
+
+	tx := &lib.TransactionWriter{}
+	record := lib.NewMemoryWriter()
+	w := lib.RecordingWriter{Writer: tx, Record: record}
+	fail := func(err error) error {
+		if err1 := tx.Rollback(); err1 != nil {
+			log.Println(err1)
+		}
+		return cli.NewExitError(err.Error(), 1)
+	}
+
+	path := lib.SettingsFile(userMode)
+	err = lib.WriteSysconfig(w, path, sysconfig.Config{key: value})
+	if err != nil {
+		return fail(err)
+	}
+
+	cfg, settings, err := loadConfig(c, verbosity)
+	if err != nil {
+		return fail(err)
+	}
+	lib.Dbg(settings.Verbosity, lib.Verbose, fmt.Sprintf("Set %s=\"%s\" in %s.\n", key, value, path))
+	affected, err := regenerate(w, settings, userMode, key)
+	if err != nil {
+		return fail(err)
+	}
+	if key == "GENERATE_TTCAP_ENTRIES" && !userMode {
+		affected = true
+		err = lib.MkFontScaleAndFontDir(settings, true)
+		if err != nil {
+			return fail(err)
+		}
+	}
+	tx.Commit()
+
+	err = lib.SaveRun(lib.NewRun(record, cfg, userMode))
+	if err != nil {
+		log.Printf("*** warning: can not record this run in history: %s\n", err.Error())
+	}
+
+	if affected && !userMode {
+		lib.FcCache(settings.Verbosity)
+	}
+	return nil
+}
+
+// newApp the command line interface of fonts-config
+func newApp() *cli.App {
 	cli.VersionFlag = cli.BoolFlag{
 		Name:  "version",
 		Usage: "Display version and exit.",
@@ -148,12 +394,473 @@ func main() {
 			Name:  "generate-java-font-setup",
 			Usage: "Generate font setup for Java.",
 		},
+		cli.BoolFlag{
+			Name:  "dry-run, n",
+			Usage: "Print the path and content of every generated file instead of writing it, and do not reload fc-cache, xset or xfs.",
+		},
+		cli.StringFlag{
+			Name:  "root",
+			Value: "/",
+			Usage: "Read and write every file relative to the alternate root `directory`, eg. an OS image being built.",
+		},
 		cli.BoolFlag{
 			Name:  "info",
 			Usage: "Print files used by fonts-config for YaST Fonts module.",
 		},
 	}
 
+	app.Before = func(c *cli.Context) error {
+		return lib.SetRoot(c.String("root"))
+	}
+
+	app.Commands = []cli.Command{
+		{
+			Name:      "diff",
+			Usage:     "Show a unified diff of the generated configuration against the installed one.",
+			UsageText: "fonts-config [global options] diff\n\n   Exit status is 0 if nothing would change, 1 if changes are pending and 2 on trouble.",
+			Action: func(c *cli.Context) error {
+				global := c.Parent()
+				_, settings, err := loadConfig(global, parseVerbosity(global))
+				if err != nil {
+					return cli.NewExitError(err.Error(), 2)
+				}
+
+				w := lib.NewMemoryWriter()
+				err = generate(w, settings, global.Bool("u"), true)
+				if err != nil {
+					return cli.NewExitError(err.Error(), 2)
+				}
+
+				changed, err := lib.DiffInstalled(w, os.Stdout)
+				if err != nil {
+					return cli.NewExitError(err.Error(), 2)
+				}
+				if changed {
+					return cli.NewExitError("", 1)
+				}
+				return nil
+			},
+		},
+		{
+			Name:      "validate",
+			Usage:     "Check the generated configuration against fonts.dtd without installing it.",
+			UsageText: "fonts-config [global options] validate [--conf-d]\n\n   Exit status is 0 if everything is valid, 1 otherwise.",
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "conf-d",
+					Usage: "Check every file installed in conf.d too.",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				global := c.Parent()
+				userMode := global.Bool("u")
+				_, settings, err := loadConfig(global, parseVerbosity(global))
+				if err != nil {
+					return cli.NewExitError(err.Error(), 1)
+				}
+
+				// the value assigned is used, a wrong "## Default:" only misleads the reader of the template
+				schema, err := loadSchema()
+				if err != nil {
+					return cli.NewExitError(err.Error(), 1)
+				}
+				for _, m := range schema.Mismatches() {
+					log.Printf("*** warning: %s: %s\n", lib.SysconfigTemplate, m)
+				}
+
+				err = generate(lib.NewMemoryWriter(), settings, userMode, true)
+				if err != nil {
+					return cli.NewExitError(err.Error(), 1)
+				}
+
+				if c.Bool("conf-d") {
+					invalid, err := lib.ValidateInstalled(userMode, os.Stdout)
+					if err != nil {
+						return cli.NewExitError(err.Error(), 1)
+					}
+					if invalid {
+						return cli.NewExitError("", 1)
+					}
+				}
+				return nil
+			},
+		},
+		{
+			Name:      "lint",
+			Usage:     "Report conflicts between shipped, generated and local files in conf.d.",
+			UsageText: "fonts-config [global options] lint\n\n   Exit status is 0 if no problem was found, 1 otherwise.",
+			Action: func(c *cli.Context) error {
+				problems, err := lib.LintConfDir(font.ReadCollection(lib.Root(), c.Parent().Bool("u")), c.Parent().Bool("u"))
+				if err != nil {
+					return cli.NewExitError(err.Error(), 1)
+				}
+				for _, p := range problems {
+					fmt.Println(p.String())
+				}
+				if len(problems) > 0 {
+					return cli.NewExitError("", 1)
+				}
+				return nil
+			},
+		},
+		{
+			Name:      "explain",
+			Usage:     "Explain which rules and installed fonts a request for a family resolves to.",
+			ArgsUsage: "FAMILY",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "lang",
+					Usage: "Resolve the family for the language `LANG` instead of the one of the locale.",
+				},
+				cli.BoolFlag{
+					Name:  "user",
+					Usage: "Include the configuration of the current user.",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				if c.NArg() != 1 {
+					return cli.NewExitError("explain needs exactly one FAMILY", 1)
+				}
+				userMode := c.Bool("user") || c.Parent().Bool("u")
+
+				exp, err := lib.Explain(font.ReadCollection(lib.Root(), userMode), c.Args().First(), c.String("lang"), userMode)
+				if err != nil {
+					return cli.NewExitError(err.Error(), 1)
+				}
+				exp.Format(os.Stdout)
+				return nil
+			},
+		},
+		{
+			Name:  "list-fonts",
+			Usage: "List the installed fonts with their families, style, weight, spacing, languages and number of characters covered.",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "format",
+					Value: "table",
+					Usage: "Print the fonts as `FORMAT`: " + strings.Join(lib.ListFormats, ", ") + ".",
+				},
+				cli.StringSliceFlag{
+					Name:  "lang",
+					Usage: "Only fonts supporting the language `LANG`, can be given several times to accept any of them.",
+				},
+				cli.StringFlag{
+					Name:  "family",
+					Usage: "Only fonts with a family name matching the regular expression `REGEXP`.",
+				},
+				cli.BoolFlag{
+					Name:  "emoji",
+					Usage: "Only emoji fonts.",
+				},
+				cli.BoolFlag{
+					Name:  "cjk",
+					Usage: "Only fonts supporting Chinese, Japanese or Korean.",
+				},
+				cli.BoolFlag{
+					Name:  "monospace",
+					Usage: "Only monospaced fonts.",
+				},
+				cli.StringFlag{
+					Name:  "dir",
+					Usage: "Only fonts installed below `DIRECTORY`.",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				fonts := font.ReadCollection(lib.Root(), c.Parent().Bool("u"))
+
+				if langs := c.StringSlice("lang"); len(langs) > 0 {
+					fonts = fonts.ByLang(langs...)
+				}
+				if len(c.String("family")) > 0 {
+					re, err := regexp.Compile(c.String("family"))
+					if err != nil {
+						return cli.NewExitError(fmt.Sprintf("invalid family regexp: %s", err.Error()), 1)
+					}
+					fonts = fonts.FindByName(re)
+				}
+				if c.Bool("emoji") {
+					fonts = fonts.Filter(font.Font.IsEmoji)
+				}
+				if c.Bool("cjk") {
+					fonts = fonts.ByLang("zh", "ja", "ko")
+				}
+				if c.Bool("monospace") {
+					fonts = fonts.BySpacing(100, 110)
+				}
+				if len(c.String("dir")) > 0 {
+					// "/" must not become "//"
+					d := strings.TrimSuffix(filepath.Clean(c.String("dir")), "/") + "/"
+					fonts = fonts.Filter(func(f font.Font) bool { return strings.HasPrefix(f.File, d) })
+				}
+
+				err := lib.ListFonts(fonts, c.String("format"), os.Stdout)
+				if err != nil {
+					return cli.NewExitError(err.Error(), 1)
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "coverage",
+			Usage: "Report which installed fonts cover every language and Unicode script fully or partially, and which scripts have no font.",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "format",
+					Value: "table",
+					Usage: "Print the report as `FORMAT`: " + strings.Join(lib.ListFormats, ", ") + ".",
+				},
+				cli.StringSliceFlag{
+					Name:  "lang",
+					Usage: "Only report the language `LANG`, can be given several times.",
+				},
+				cli.StringSliceFlag{
+					Name:  "script",
+					Usage: "Only report the Unicode script `SCRIPT`, eg. Thai, Devanagari or Ethiopic, can be given several times.",
+				},
+				cli.BoolFlag{
+					Name:  "missing",
+					Usage: "Only report the languages and scripts no font has any character of.",
+				},
+				cli.BoolFlag{
+					Name:  "fail-missing",
+					Usage: "Exit with status 1 when a reported language or script has no font.",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				fonts := font.ReadCollection(lib.Root(), c.Parent().Bool("u"))
+
+				coverage, err := lib.NewCoverage(fonts, c.StringSlice("lang"), c.StringSlice("script"))
+				if err != nil {
+					return cli.NewExitError(err.Error(), 1)
+				}
+
+				missing := 0
+				var report []lib.Coverage
+				for _, cov := range coverage {
+					if cov.Status() == "none" {
+						missing++
+					} else if c.Bool("missing") {
+						continue
+					}
+					report = append(report, cov)
+				}
+
+				err = lib.WriteCoverage(report, c.String("format"), os.Stdout)
+				if err != nil {
+					return cli.NewExitError(err.Error(), 1)
+				}
+				if c.Bool("fail-missing") && missing > 0 {
+					return cli.NewExitError(fmt.Sprintf("%d languages or scripts have no font", missing), 1)
+				}
+				return nil
+			},
+		},
+		{
+			Name:      "get",
+			Usage:     "Print the effective value of the given settings, or of every setting.",
+			ArgsUsage: "[KEY...]",
+			Action: func(c *cli.Context) error {
+				global := c.Parent()
+				cfg, _, err := loadConfig(global, parseVerbosity(global))
+				if err != nil {
+					return cli.NewExitError(err.Error(), 1)
+				}
+				schema, err := loadSchema()
+				if err != nil {
+					return cli.NewExitError(err.Error(), 1)
+				}
+
+				keys := c.Args()
+				if len(keys) == 0 {
+					for _, o := range schema {
+						keys = append(keys, o.Key)
+					}
+				}
+				for _, k := range keys {
+					if _, ok := schema.Lookup(k); !ok {
+						return cli.NewExitError(fmt.Sprintf("unknown setting %s", k), 1)
+					}
+					fmt.Printf("%s=\"%s\"\n", k, cfg[k])
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "config",
+			Usage: "Print the effective value of every setting, merged from defaults, sysconfig, the user's settings file, FONTS_CONFIG_* environment variables and flags.",
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "show-origin",
+					Usage: "Print the layer each value comes from.",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				global := c.Parent()
+				schema, err := loadSchema()
+				if err != nil {
+					return cli.NewExitError(err.Error(), 1)
+				}
+				layers, err := loadLayers(global, schema, parseVerbosity(global))
+				if err != nil {
+					return cli.NewExitError(err.Error(), 1)
+				}
+				cfg, origins := sysconfig.Merge(layers...)
+				if _, err := schema.Settings(cfg); err != nil {
+					fmt.Fprintf(os.Stderr, "*** warning: invalid configuration:\n%s\n", err.Error())
+				}
+
+				for _, o := range schema {
+					if c.Bool("show-origin") {
+						fmt.Printf("%s\t", origins[o.Key])
+					}
+					fmt.Printf("%s=\"%s\"\n", o.Key, cfg[o.Key])
+				}
+				return nil
+			},
+		},
+		{
+			Name:      "set",
+			Usage:     "Persist a setting to the sysconfig file (the user's settings file with --user) and regenerate the files depending on it.",
+			ArgsUsage: "KEY=VALUE",
+			Action: func(c *cli.Context) error {
+				arr := strings.SplitN(c.Args().First(), "=", 2)
+				if len(arr) < 2 {
+					return cli.NewExitError("usage: fonts-config set KEY=VALUE", 1)
+				}
+				return updateSetting(c.Parent(), arr[0], arr[1])
+			},
+		},
+		{
+			Name:      "reset",
+			Usage:     "Reset a setting to its default value and regenerate the files depending on it.",
+			ArgsUsage: "KEY",
+			Action: func(c *cli.Context) error {
+				key := c.Args().First()
+				schema, err := loadSchema()
+				if err != nil {
+					return cli.NewExitError(err.Error(), 1)
+				}
+				o, ok := schema.Lookup(key)
+				if !ok {
+					return cli.NewExitError(fmt.Sprintf("unknown setting %s", key), 1)
+				}
+				return updateSetting(c.Parent(), key, o.Default)
+			},
+		},
+		{
+			Name:  "migrate",
+			Usage: "Update /etc/sysconfig/fonts-config to the defaults of the installed sysconfig template and regenerate the configuration, eg. from package scriptlets.",
+			Action: func(c *cli.Context) error {
+				global := c.Parent()
+				chkPermission(false)
+
+				tx := &lib.TransactionWriter{}
+				record := lib.NewMemoryWriter()
+				w := lib.RecordingWriter{Writer: tx, Record: record}
+				fail := func(err error) error {
+					if err1 := tx.Rollback(); err1 != nil {
+						log.Println(err1)
+					}
+					return cli.NewExitError(err.Error(), 1)
+				}
+
+				changes, err := lib.Migrate(w)
+				if err != nil {
+					return fail(err)
+				}
+				// the generated files follow the migrated settings like after set
+				cfg, settings, err := loadConfig(global, parseVerbosity(global))
+				if err != nil {
+					return fail(err)
+				}
+				if len(changes) > 0 {
+					err = generate(w, settings, false, false)
+					if err != nil {
+						return fail(err)
+					}
+				}
+				tx.Commit()
+
+				for _, change := range changes {
+					fmt.Printf("fonts-config: %s\n", change)
+				}
+				if len(changes) == 0 {
+					return nil
+				}
+
+				err = lib.SaveRun(lib.NewRun(record, cfg, false))
+				if err != nil {
+					log.Printf("*** warning: can not record this run in history: %s\n", err.Error())
+				}
+				lib.FcCache(settings.Verbosity)
+				return nil
+			},
+		},
+		{
+			Name:  "history",
+			Usage: "List the recorded runs, newest first, with the settings changed by each.",
+			Action: func(c *cli.Context) error {
+				runs, err := lib.LoadHistory(c.Parent().Bool("u"))
+				if err != nil {
+					return cli.NewExitError(err.Error(), 1)
+				}
+				fmt.Print(lib.FormatHistory(runs))
+				return nil
+			},
+		},
+		{
+			Name:      "rollback",
+			Usage:     "Restore every generated file to its state after run N of history (default: the run before the one in effect, so repeated rollbacks go further back).",
+			ArgsUsage: "[N]",
+			Action: func(c *cli.Context) error {
+				global := c.Parent()
+				userMode := global.Bool("u")
+				chkPermission(userMode)
+				verbosity := parseVerbosity(global)
+
+				runs, err := lib.LoadHistory(userMode)
+				if err != nil {
+					return cli.NewExitError(err.Error(), 1)
+				}
+
+				n := lib.DefaultRollback(runs)
+				if c.NArg() > 0 {
+					i, err := strconv.Atoi(c.Args().First())
+					if err != nil {
+						return cli.NewExitError("N must be a number", 1)
+					}
+					n = i
+				}
+
+				tx := &lib.TransactionWriter{}
+				record := lib.NewMemoryWriter()
+				run, err := lib.RollbackTo(lib.RecordingWriter{Writer: tx, Record: record}, runs, n)
+				if err != nil {
+					if err1 := tx.Rollback(); err1 != nil {
+						log.Println(err1)
+					}
+					return cli.NewExitError(err.Error(), 1)
+				}
+				tx.Commit()
+
+				lib.Dbg(verbosity, lib.Verbose, fmt.Sprintf("Restored %d files from run of %s.\n", len(record.Paths), run.Time.Local().Format("2006-01-02 15:04:05")))
+
+				// the rollback is recorded too, but not counted as a run to roll back to
+				rollback := lib.NewRun(record, sysconfig.Config(run.Config), userMode)
+				rollback.Restored = &run.Time
+				err = lib.SaveRun(rollback)
+				if err != nil {
+					log.Printf("*** warning: can not record this run in history: %s\n", err.Error())
+				}
+
+				if !userMode {
+					lib.FcCache(verbosity)
+				}
+				return nil
+			},
+		},
+	}
+
 	app.Action = func(c *cli.Context) error {
 
 		if c.Bool("info") {
@@ -161,80 +868,85 @@ func main() {
 			os.Exit(0)
 		}
 
-		currentUser, _ := user.Current()
-		if !c.Bool("u") && currentUser.Uid != "0" && currentUser.Username != "root" {
-			log.Fatal("*** error: no root permissions; rerun with --user for user fontconfig setting.")
-		}
+		chkPermission(c.Bool("u") || c.Bool("dry-run"))
 
-		// parse verbosity
-		verbosity := 0
-		if c.Bool("d") {
-			verbosity = 256
-		}
-		if c.Bool("v") {
-			verbosity = 1
+		verbosity := parseVerbosity(c)
+
+		tx := &lib.TransactionWriter{}
+		record := lib.NewMemoryWriter()
+		var w lib.Writer = lib.RecordingWriter{Writer: tx, Record: record}
+		if c.Bool("dry-run") {
+			w = lib.DryRunWriter{Out: os.Stdout}
 		}
 
-		if c.Bool("r") {
-			rmUserFcConfig(c.Bool("u"))
-			os.Exit(0)
+		cfg, settings, err := loadConfig(c, verbosity)
+		if err != nil {
+			return cli.NewExitError(err.Error(), 1)
 		}
 
-		cfg := make(sysconfig.Config)
-		f := ioutils.NewReaderFromFile("/etc/sysconfig/fonts-config")
-		cfg.Unmarshal(f)
-		cfg["VERBOSITY"] = verbosity
+		if c.Bool("r") {
+			err = rmUserFcConfig(w, c.Bool("u"))
+			if err != nil {
+				if err1 := tx.Rollback(); err1 != nil {
+					log.Println(err1)
+				}
+				return cli.NewExitError(err.Error(), 1)
+			}
+			tx.Commit()
 
-		// overwrite cfg with cli args
-		for k, v := range cfg {
-			flag := strings.ReplaceAll(strings.ToLower(k), "_", "-")
-			if c.IsSet(flag) {
-				if reflect.TypeOf(v).Kind() == reflect.Bool {
-					cfg[k] = c.Bool(flag)
-					continue
+			if !c.Bool("dry-run") {
+				err = lib.SaveRun(lib.NewRun(record, cfg, c.Bool("u")))
+				if err != nil {
+					log.Printf("*** warning: can not record this run in history: %s\n", err.Error())
 				}
-				cfg[k] = c.String(flag)
 			}
+			return nil
 		}
 
-		lib.Dbg(verbosity, lib.Debug, func(mode bool) string {
+		lib.Dbg(settings.Verbosity, lib.Debug, func(mode bool) string {
 			if mode {
 				return fmt.Sprintf("--- USER mode (%s)\n", os.Getenv("USER"))
 			}
 			return fmt.Sprintf("--- SYSTEM mode\n")
 		}, c.Bool("u"))
 
-		if !c.Bool("u") {
-			err := lib.MkFontScaleAndFontDir(cfg, c.Bool("force"))
+		// fonts.scale and fonts.dir are maintained by mkfontscale/mkfontdir, can't be previewed
+		if !c.Bool("u") && !c.Bool("dry-run") {
+			err := lib.MkFontScaleAndFontDir(settings, c.Bool("force"))
 			if err != nil {
 				log.Fatal(err)
 			}
-			lib.GenMetricCompatibility(verbosity)
-		}
-
-		/*	# The following two calls may change files in /etc/fonts, therefore
-			# they have to be called *before* fc-cache. If anything is
-			# changed in /etc/fonts after calling fc-cache, fontconfig
-			# will think that the cache files are out of date again. */
-
-		collection := font.NewCollection()
-		lib.GenRenderingOptions(c.Bool("u"), cfg)
-		lib.GenFamilyPreferenceLists(c.Bool("u"), cfg)
-		lib.GenEmojiBlacklist(collection, c.Bool("u"), cfg)
-		lib.GenNotoConfig(collection, c.Bool("u"))
-		lib.GenCJKConfig(collection, c.Bool("u"))
-
-		if !c.Bool("u") {
-			lib.FcCache(cfg.Int("VERBOSITY"))
-			lib.FpRehash(cfg.Int("VERBOSITY"))
-			if cfg.Bool("GENERATE_JAVA_FONT_SETUP") {
-				lib.GenerateJavaFontSetup(collection)
+		}
+
+		err = generate(w, settings, c.Bool("u"), c.Bool("dry-run"))
+		if err != nil {
+			// bring every generated file back to the last consistent state
+			if err1 := tx.Rollback(); err1 != nil {
+				log.Println(err1)
+			}
+			log.Fatal(err)
+		}
+		tx.Commit()
+
+		if !c.Bool("dry-run") {
+			err = lib.SaveRun(lib.NewRun(record, cfg, c.Bool("u")))
+			if err != nil {
+				log.Printf("*** warning: can not record this run in history: %s\n", err.Error())
 			}
-			lib.ReloadXorgFontServer(cfg.Int("VERBOSITY"))
+		}
+
+		if !c.Bool("u") && !c.Bool("dry-run") {
+			lib.FcCache(settings.Verbosity)
+			lib.FpRehash(settings.Verbosity)
+			lib.ReloadXorgFontServer(settings.Verbosity)
 		}
 
 		return nil
 	}
 
-	_ = app.Run(os.Args)
+	return app
+}
+
+func main() {
+	_ = newApp().Run(os.Args)
 }
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/marguerite/fonts-config-ng/lib"
+	"github.com/urfave/cli"
+)
+
+func TestDiffInvalidConfiguration(t *testing.T) {
+	dir := t.TempDir()
+	template, err := os.ReadFile("../data/sysconfig.fonts-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string][]byte{
+		lib.SysconfigTemplate: template,
+		lib.SysconfigFile:     []byte("FORCE_BW=\"maybe\"\n"),
+	}
+	for path, content := range files {
+		path = filepath.Join(dir, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, content, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	code := -1
+	var stderr bytes.Buffer
+	exiter, errWriter := cli.OsExiter, cli.ErrWriter
+	cli.OsExiter = func(c int) { code = c }
+	cli.ErrWriter = &stderr
+	defer func() { cli.OsExiter, cli.ErrWriter = exiter, errWriter }()
+
+	// 1 means changes are pending, trouble must not be mistaken for it
+	newApp().Run([]string{"fonts-config", "--root", dir, "diff"})
+	if code != 2 {
+		t.Errorf("exit status %d, want 2", code)
+	}
+	if !strings.Contains(stderr.String(), "FORCE_BW") {
+		t.Errorf("error %q does not name the invalid setting", stderr.String())
+	}
+}
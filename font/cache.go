@@ -0,0 +1,167 @@
+package font
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// cacheVersion bump whenever Font or the way font files are read changes, older caches are discarded
+const cacheVersion int = 2
+
+// SystemCacheFile the cache of the parsed system fonts, relative to the sysroot
+const SystemCacheFile string = "/var/cache/fonts-config/fonts.json"
+
+// cacheEntry the fonts read from a file, valid as long as the file keeps its size, mtime and inode
+type cacheEntry struct {
+	Size    int64
+	ModTime int64
+	Inode   uint64
+	Fonts   Collection
+}
+
+// fontCache parsed font files by their path relative to the sysroot
+type fontCache struct {
+	Version int
+	Files   map[string]cacheEntry
+}
+
+// CacheFile the font cache of the system, or of the user in userMode, relative to the sysroot
+func CacheFile(userMode bool) string {
+	if !userMode {
+		return SystemCacheFile
+	}
+	cache := os.Getenv("XDG_CACHE_HOME")
+	if len(cache) == 0 {
+		home, _ := os.UserHomeDir()
+		cache = filepath.Join(home, ".cache")
+	}
+	return filepath.Join(cache, "fonts-config/fonts.json")
+}
+
+// newCacheEntry an entry for the fonts read from the file described by info
+func newCacheEntry(info os.FileInfo, fonts Collection) cacheEntry {
+	return cacheEntry{info.Size(), info.ModTime().UnixNano(), fileInode(info), fonts}
+}
+
+// fresh whether the entry still describes the file
+func (e cacheEntry) fresh(info os.FileInfo) bool {
+	return e.Size == info.Size() && e.ModTime == info.ModTime().UnixNano() && e.Inode == fileInode(info)
+}
+
+// stat the file attributes the entry is valid for
+func (e cacheEntry) stat() [3]int64 {
+	return [3]int64{e.Size, e.ModTime, int64(e.Inode)}
+}
+
+// same whether c and c1 cache the same versions of the same files
+func (c fontCache) same(c1 fontCache) bool {
+	if len(c.Files) != len(c1.Files) {
+		return false
+	}
+	for file, e := range c.Files {
+		if e1, ok := c1.Files[file]; !ok || e1.stat() != e.stat() {
+			return false
+		}
+	}
+	return true
+}
+
+// loadCache read a font cache, a missing, broken or outdated one is just empty
+func loadCache(path string) fontCache {
+	cache := fontCache{cacheVersion, make(map[string]cacheEntry)}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return cache
+	}
+	var c fontCache
+	if json.Unmarshal(b, &c) != nil || c.Version != cacheVersion || c.Files == nil {
+		return cache
+	}
+	return c
+}
+
+// save write the cache to path atomically
+func (c fontCache) save(path string) error {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".fonts.json")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(b)
+	if err1 := tmp.Close(); err == nil {
+		err = err1
+	}
+	if err != nil {
+		return err
+	}
+	err = os.Chmod(tmp.Name(), 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// LoadCollection like NewCollection, but only the font files changed since they were cached are read again.
+// the system cache is always consulted, in userMode the user cache too, and the cache of the mode is
+// updated. failing to write the cache, eg. as an unprivileged user, is not an error.
+func LoadCollection(sysroot string, userMode bool) Collection {
+	return loadCollection(sysroot, userMode, true)
+}
+
+// ReadCollection like LoadCollection, but no cache is ever written, for the commands only reading the system
+func ReadCollection(sysroot string, userMode bool) Collection {
+	return loadCollection(sysroot, userMode, false)
+}
+
+// loadCollection scan the fonts with the help of the caches, save the cache of the mode if it changed
+func loadCollection(sysroot string, userMode, save bool) Collection {
+	system := loadCache(filepath.Join(sysroot, SystemCacheFile))
+	caches := []fontCache{system}
+	var user fontCache
+	if userMode {
+		user = loadCache(filepath.Join(sysroot, CacheFile(true)))
+		caches = append([]fontCache{user}, caches...)
+	}
+
+	lookup := func(file string, info os.FileInfo) (cacheEntry, bool) {
+		for _, c := range caches {
+			if e, ok := c.Files[file]; ok && e.fresh(info) {
+				return e, true
+			}
+		}
+		return cacheEntry{}, false
+	}
+
+	fonts, entries := scanFiles(sysroot, GetFontPaths(sysroot, userMode), lookup)
+	if !save {
+		return fonts
+	}
+
+	// the user cache only keeps what the system cache doesn't know
+	updated := fontCache{cacheVersion, make(map[string]cacheEntry)}
+	for file, e := range entries {
+		if old, ok := system.Files[file]; userMode && ok && old.stat() == e.stat() {
+			continue
+		}
+		updated.Files[file] = e
+	}
+	current := system
+	if userMode {
+		current = user
+	}
+	if !updated.same(current) {
+		updated.save(filepath.Join(sysroot, CacheFile(userMode)))
+	}
+
+	return fonts
+}
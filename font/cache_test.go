@@ -0,0 +1,150 @@
+package font
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// cacheRoot a sysroot with one font in /usr/share/fonts
+func cacheRoot(t *testing.T) (string, string) {
+	sysroot := t.TempDir()
+	t.Setenv("HOME", "/root")
+	t.Setenv("XDG_DATA_HOME", "")
+	files := map[string][]byte{
+		"etc/fonts/fonts.conf":          []byte(`<fontconfig><dir>/usr/share/fonts</dir></fontconfig>`),
+		"usr/share/fonts/test-sans.ttf": buildFace(0, testFace()),
+	}
+	for path, content := range files {
+		path = filepath.Join(sysroot, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, content, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return sysroot, filepath.Join(sysroot, "usr/share/fonts/test-sans.ttf")
+}
+
+// poisonCache rename every cached font, so a font read from the cache can be told from one read from disk
+func poisonCache(t *testing.T, sysroot string) {
+	path := filepath.Join(sysroot, SystemCacheFile)
+	c := loadCache(path)
+	if len(c.Files) == 0 {
+		t.Fatal("nothing cached")
+	}
+	for file, e := range c.Files {
+		for i := range e.Fonts {
+			e.Fonts[i].Name = []string{"Cached"}
+		}
+		c.Files[file] = e
+	}
+	if err := c.save(path); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func names(c Collection) [][]string {
+	var n [][]string
+	for _, f := range c {
+		n = append(n, f.Name)
+	}
+	return n
+}
+
+func TestCacheHit(t *testing.T) {
+	sysroot, _ := cacheRoot(t)
+	if got := LoadCollection(sysroot, false); len(got) != 1 || got[0].Name[0] != "Test Sans" {
+		t.Fatalf("scanned %v", names(got))
+	}
+	poisonCache(t, sysroot)
+	if got := LoadCollection(sysroot, false); !reflect.DeepEqual(names(got), [][]string{{"Cached"}}) {
+		t.Errorf("read %v, want the cached font", names(got))
+	}
+}
+
+func TestCacheInvalidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(t *testing.T, file string)
+	}{
+		{"size", func(t *testing.T, file string) {
+			face := testFace()
+			face["glyf"] = make([]byte, 64)
+			if err := os.WriteFile(file, buildFace(0, face), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{"mtime", func(t *testing.T, file string) {
+			if err := os.Chtimes(file, time.Now(), time.Now().Add(time.Hour)); err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{"inode", func(t *testing.T, file string) {
+			info, err := os.Stat(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// same size and mtime, but a new file
+			tmp := file + ".new"
+			if err := os.WriteFile(tmp, buildFace(0, testFace()), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.Chtimes(tmp, info.ModTime(), info.ModTime()); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.Rename(tmp, file); err != nil {
+				t.Fatal(err)
+			}
+		}},
+	}
+	for _, tt := range tests {
+		sysroot, file := cacheRoot(t)
+		LoadCollection(sysroot, false)
+		poisonCache(t, sysroot)
+		tt.change(t, file)
+		if got := LoadCollection(sysroot, false); len(got) != 1 || got[0].Name[0] != "Test Sans" {
+			t.Errorf("%s changed: read %v, want the font read again", tt.name, names(got))
+		}
+	}
+}
+
+func TestCacheCorrupt(t *testing.T) {
+	for _, content := range []string{"{", `{"Version": 1, "Files": {}}`, `{"Version": 2}`} {
+		sysroot, _ := cacheRoot(t)
+		path := filepath.Join(sysroot, SystemCacheFile)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if got := LoadCollection(sysroot, false); len(got) != 1 {
+			t.Errorf("cache %q: read %v", content, names(got))
+		}
+		if c := loadCache(path); len(c.Files) != 1 {
+			t.Errorf("cache %q not replaced", content)
+		}
+	}
+}
+
+func TestCacheSkipsUnreadable(t *testing.T) {
+	sysroot, _ := cacheRoot(t)
+	// a directory can be stat'ed but not read, like a file without read permission
+	if err := os.MkdirAll(filepath.Join(sysroot, "usr/share/fonts/unreadable.ttf"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	fonts, entries := scanFiles(sysroot, []string{"/usr/share/fonts/test-sans.ttf", "/usr/share/fonts/unreadable.ttf"}, nil)
+	if len(fonts) != 1 {
+		t.Errorf("read %v", names(fonts))
+	}
+	if _, ok := entries["/usr/share/fonts/unreadable.ttf"]; ok {
+		t.Error("a file that could not be read is cached")
+	}
+	if _, ok := entries["/usr/share/fonts/test-sans.ttf"]; !ok {
+		t.Error("the font is not cached")
+	}
+}
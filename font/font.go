@@ -2,15 +2,18 @@ package font
 
 import (
 	"fmt"
-	"log"
-	"os/exec"
+	"io/ioutil"
+	"os"
 	"path/filepath"
 	"reflect"
 	"regexp"
-	"strconv"
+	"runtime"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/marguerite/fonts-config-ng/charset"
+	"github.com/marguerite/fonts-config-ng/fontconfig"
 	"github.com/marguerite/go-stdlib/slice"
 	"github.com/marguerite/go-stdlib/stringutils"
 )
@@ -18,38 +21,115 @@ import (
 // Collection A collection of type Font
 type Collection []Font
 
-// NewCollection Initialize a new collection of Font from system installed fonts queried by fc-cat.
-func NewCollection() Collection {
-	paths := GetFontPaths()
-	out, err := exec.Command("/usr/bin/fc-cat").Output()
-	if err != nil {
-		panic(err)
+// NewCollection Initialize a new collection of Font from the font files installed into sysroot,
+// "/" for the running system, the user's fonts too in userMode. the files are read natively,
+// no fontconfig binaries are needed.
+func NewCollection(sysroot string, userMode bool) Collection {
+	fonts, _ := scanFiles(sysroot, GetFontPaths(sysroot, userMode), nil)
+	return fonts
+}
+
+// scanFiles read the fonts of files, unless lookup has a cache entry for them.
+// returns the fonts in the order of files and the cache entries of every file.
+func scanFiles(sysroot string, files []string, lookup func(string, os.FileInfo) (cacheEntry, bool)) (Collection, map[string]cacheEntry) {
+	var sfnts []string
+	for _, f := range files {
+		// reject font formats usually not used for display, only sfnt fonts are read
+		if ok, _, _ := stringutils.Contains(strings.ToLower(f), ".ttf", ".ttc", ".otf", ".otc"); ok {
+			sfnts = append(sfnts, f)
+		}
 	}
 
+	// font files are parsed in parallel, the results keep the order of files
+	results := make([]cacheEntry, len(sfnts))
+	valid := make([]bool, len(sfnts))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < runtime.NumCPU(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				info, err := os.Stat(filepath.Join(sysroot, sfnts[j]))
+				if err != nil {
+					continue
+				}
+				if lookup != nil {
+					if e, ok := lookup(sfnts[j], info); ok {
+						results[j], valid[j] = e, true
+						continue
+					}
+				}
+				// files which are not fonts are cached too, so they are not read again,
+				// but a file that could not be read at all, eg. for its permissions, is tried next time
+				fonts, err := ScanFile(sysroot, sfnts[j])
+				if _, ok := err.(*os.PathError); ok {
+					continue
+				}
+				results[j], valid[j] = newCacheEntry(info, fonts), true
+			}
+		}()
+	}
+	for i := range sfnts {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
 	fonts := Collection{}
+	entries := make(map[string]cacheEntry)
+	for i, e := range results {
+		if valid[i] {
+			fonts = append(fonts, e.Fonts...)
+			entries[sfnts[i]] = e
+		}
+	}
+	return fonts, entries
+}
 
-	for _, line := range strings.Split(string(out), "\n") {
-		font := Font{}
-		for _, i := range strings.Split(line, "\"") {
-			if len(i) == 0 {
-				continue
-			}
-			if _, err := strconv.Atoi(strings.TrimSpace(i)); err == nil {
-				continue
-			}
-			// reject directory and font format usually not used for display
-			if ok, _, _ := stringutils.Contains(i, ".dir", ".pcf.gz", ".pfa", ".pfb", ".afm", ".gsf", ".otb"); !ok {
-				// Multi thread here
-				NewFont(&font, i, paths)
-			}
+// ScanFile read every face of the TrueType/OpenType font or font collection file,
+// a path inside sysroot
+func ScanFile(sysroot, file string) (Collection, error) {
+	data, err := ioutil.ReadFile(filepath.Join(sysroot, file))
+	if err != nil {
+		return nil, err
+	}
+	faces, err := parseSfnt(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", file, err.Error())
+	}
 
+	fonts := Collection{}
+	for i, face := range faces {
+		names := face.names()
+		if len(names.family) == 0 {
+			continue
 		}
-		if len(font.File) != 0 {
-			fonts = append(fonts, font)
+		font := Font{File: file,
+			Index:          i,
+			Name:           names.family,
+			Style:          names.style,
+			Fullname:       names.fullname,
+			PostscriptName: names.postscript,
+			Foundry:        face.foundry(),
+			Width:          face.width(),
+			Weight:         face.weight(),
+			Slant:          face.slant(),
+			Spacing:        face.spacing(),
+			Outline:        face.outline(),
+			Scalable:       face.scalable(),
+			Color:          face.color(),
+			Variable:       face.variable(),
+			PixelSize:      face.pixelSizes(),
+			FontFormat:     face.format(),
+			FontVersion:    face.version(),
+			Capability:     face.capability(),
+			Charset:        face.charset(),
 		}
+		font.Lang = langs(font.Charset, face.exclusiveLang())
+		fonts = append(fonts, font)
 	}
-
-	return fonts
+	return fonts, nil
 }
 
 // FindByName Find Fonts by font name string or font name regexp pattern
@@ -73,58 +153,31 @@ func (c Collection) FilterNameList(list *[]string) {
 	}
 }
 
-// Font font struct with informations we need
+// Font font struct with informations we need, named after the fontconfig pattern elements
 type Font struct {
-	File    string
-	Name    []string
-	Lang    []string
-	Width   int
-	Weight  int
-	Slant   int
-	Spacing int
-	Outline bool
+	File           string
+	Index          int
+	Name           []string
+	Style          []string
+	Fullname       []string
+	PostscriptName string
+	Foundry        string
+	Lang           []string
+	Width          int
+	Weight         int
+	Slant          int
+	Spacing        int
+	Outline        bool
+	Scalable       bool
+	Color          bool
+	Variable       bool
+	PixelSize      []float64
+	FontFormat     string
+	FontVersion    int
+	Capability     string
 	charset.Charset
 }
 
-// NewFont generate a new Font structure from input string
-func NewFont(font *Font, in string, paths map[string]string) {
-	// parse Filename or other information
-	if strings.Contains(in, ":") {
-		for idx, i := range strings.Split(in, ":") {
-			if idx == 0 {
-				font.Name = strings.Split(strings.TrimSpace(i), ",")
-				continue
-			}
-			arr := strings.Split(i, "=")
-			if ok, err := slice.Contains([]string{"width", "weight", "slant", "spacing"}, arr[0]); ok && err == nil {
-				// convert to value to int
-				val, err := strconv.ParseInt(arr[1], 10, 64)
-				if err != nil {
-					continue
-				}
-
-				v := reflect.Indirect(reflect.ValueOf(font))
-				v.FieldByName(strings.Title(arr[0])).SetInt(val)
-			}
-			if arr[0] == "outline" {
-				val, err := strconv.ParseBool(i)
-				if err != nil {
-					continue
-				}
-				font.Outline = val
-			}
-			if arr[0] == "lang" {
-				font.Lang = strings.Split(strings.TrimSpace(arr[1]), "|")
-			}
-			if arr[0] == "charset" {
-				font.Charset = charset.NewCharset(strings.TrimSpace(arr[1]))
-			}
-		}
-	} else {
-		font.File = paths[in]
-	}
-}
-
 // IsEmoji whether a font is a emoji font
 func (f Font) IsEmoji() bool {
 	if ok, err := slice.Contains(f.Lang, "und-zsye"); ok && err == nil {
@@ -159,33 +212,82 @@ func getMatchedFontName(names []string, restricts ...interface{}) ([]string, err
 	return []string{}, fmt.Errorf("no matched name found")
 }
 
-// GetFontPaths get all system installed font's paths via fc-list
-func GetFontPaths() map[string]string {
-	out, err := exec.Command("/usr/bin/fc-list").Output()
-	if err != nil {
-		log.Fatal("no fc-list found")
+// resolveLink the path in sysroot a symlink at path points to, absolute targets are inside sysroot too.
+// path itself if it is not a symlink.
+func resolveLink(sysroot, path string) (string, error) {
+	for i := 0; i < 40; i++ {
+		target, err := os.Readlink(path)
+		if err != nil {
+			return path, nil
+		}
+		if filepath.IsAbs(target) {
+			path = filepath.Join(sysroot, target)
+		} else {
+			path = filepath.Join(filepath.Dir(path), target)
+		}
 	}
+	return "", fmt.Errorf("too many levels of symbolic links: %s", path)
+}
 
-	tmp := []byte{}
-	fonts := make(map[string]string)
-	first := true
-
-	for _, b := range out {
-		if b == ':' {
-			if first {
-				font := string(tmp)
-				fonts[filepath.Base(font)] = font
-			}
-			tmp = []byte{}
-			first = false
+// walkFonts call fn for every file below dir, a path in sysroot, following symlinks inside sysroot.
+// files are passed at the path they resolve to, every directory is read once.
+func walkFonts(sysroot, dir string, visited map[string]bool, fn func(path string)) {
+	dir, err := resolveLink(sysroot, dir)
+	if err != nil || visited[dir] {
+		return
+	}
+	visited[dir] = true
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		// unreadable or missing directories are skipped
+		return
+	}
+	for _, e := range entries {
+		path, err := resolveLink(sysroot, filepath.Join(dir, e.Name()))
+		if err != nil {
 			continue
 		}
-		if b == '\n' {
-			tmp = []byte{}
-			first = true
+		info, err := os.Stat(path)
+		if err != nil {
 			continue
 		}
-		tmp = append(tmp, b)
+		if info.IsDir() {
+			walkFonts(sysroot, path, visited, fn)
+			continue
+		}
+		fn(path)
+	}
+}
+
+// GetFontPaths get all font's paths installed into sysroot by walking the font directories of the
+// fontconfig configuration, those in the home directory only in userMode. the paths are relative to
+// sysroot and sorted.
+func GetFontPaths(sysroot string, userMode bool) []string {
+	suffixes := []string{".ttf", ".ttc", ".otf", ".otc", ".otb", ".pfa", ".pfb", ".pcf", ".pcf.gz", ".bdf", ".bdf.gz", ".pfr", ".woff", ".woff2"}
+	var fonts []string
+	seen := make(map[string]bool)
+	visited := make(map[string]bool)
+
+	for _, dir := range fontconfig.FontDirs(sysroot, userMode) {
+		walkFonts(sysroot, filepath.Join(sysroot, dir), visited, func(path string) {
+			lower := strings.ToLower(path)
+			for _, suffix := range suffixes {
+				if !strings.HasSuffix(lower, suffix) {
+					continue
+				}
+				rel, err := filepath.Rel(sysroot, path)
+				if err != nil {
+					return
+				}
+				font := filepath.Join("/", rel)
+				if !seen[font] {
+					fonts = append(fonts, font)
+					seen[font] = true
+				}
+				return
+			}
+		})
 	}
+	sort.Strings(fonts)
 	return fonts
 }
@@ -0,0 +1,68 @@
+package font
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestGetFontPaths(t *testing.T) {
+	sysroot := t.TempDir()
+	t.Setenv("HOME", "/root")
+	t.Setenv("XDG_DATA_HOME", "")
+	files := map[string]string{
+		"etc/fonts/fonts.conf": `<fontconfig>
+	<dir>/usr/share/fonts</dir>
+	<dir>/usr/local/share/fonts</dir>
+	<dir prefix="xdg">fonts</dir>
+	<dir>~/.fonts</dir>
+</fontconfig>`,
+		"usr/share/fonts/truetype/a.ttf":  "",
+		"usr/share/fonts/truetype/README": "",
+		"opt/vendor/fonts/b.otf":          "",
+		"opt/vendor/fonts/sub/c.TTC":      "",
+		"root/.fonts/d.ttf":               "",
+		"root/.local/share/fonts/e.ttf":   "",
+	}
+	for path, content := range files {
+		path = filepath.Join(sysroot, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	links := map[string]string{
+		// an absolute link resolves inside sysroot
+		"usr/local/share/fonts":  "/opt/vendor/fonts",
+		"usr/share/fonts/vendor": "../../../opt/vendor/fonts",
+		"usr/share/fonts/loop":   ".",
+		"usr/share/fonts/f.ttf":  "truetype/a.ttf",
+		"usr/share/fonts/g.ttf":  "missing.ttf",
+	}
+	for path, target := range links {
+		path = filepath.Join(sysroot, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Symlink(target, path); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		userMode bool
+		want     []string
+	}{
+		{false, []string{"/opt/vendor/fonts/b.otf", "/opt/vendor/fonts/sub/c.TTC", "/usr/share/fonts/truetype/a.ttf"}},
+		{true, []string{"/opt/vendor/fonts/b.otf", "/opt/vendor/fonts/sub/c.TTC", "/root/.fonts/d.ttf",
+			"/root/.local/share/fonts/e.ttf", "/usr/share/fonts/truetype/a.ttf"}},
+	}
+	for _, tt := range tests {
+		if got := GetFontPaths(sysroot, tt.userMode); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("user mode %t: got %q, want %q", tt.userMode, got, tt.want)
+		}
+	}
+}
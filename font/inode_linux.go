@@ -0,0 +1,17 @@
+//go:build linux
+// +build linux
+
+package font
+
+import (
+	"os"
+	"syscall"
+)
+
+// fileInode get the inode number of a file
+func fileInode(info os.FileInfo) uint64 {
+	if st, ok := info.Sys().(*syscall.Stat_t); ok {
+		return uint64(st.Ino)
+	}
+	return 0
+}
@@ -0,0 +1,11 @@
+//go:build !linux
+// +build !linux
+
+package font
+
+import "os"
+
+// fileInode inode numbers are unknown on this platform
+func fileInode(info os.FileInfo) uint64 {
+	return 0
+}
@@ -0,0 +1,118 @@
+package font
+
+import (
+	"sort"
+
+	"github.com/marguerite/fonts-config-ng/charset"
+)
+
+//go:generate go run makeorth.go -output orthographies.go
+
+// langMissingThreshold a font supports a language when fewer of the characters of its orthography
+// than this are missing. fontconfig 2.14 tolerates none.
+const langMissingThreshold int = 1
+
+// exclusiveLangs the CJK languages a font advertises through the code page ranges of its OS/2 table,
+// indexed by the bit of ulCodePageRange1, like FcCodePageRange
+var exclusiveLangs = map[uint]string{17: "ja", 18: "zh-cn", 19: "ko", 20: "zh-tw"}
+
+// orthographyCharsets orthographies parsed once
+var orthographyCharsets = func() map[string]charset.Charset {
+	m := make(map[string]charset.Charset, len(orthographies))
+	for lang, chars := range orthographies {
+		m[lang] = charset.NewCharset(chars)
+	}
+	return m
+}()
+
+// missing the number of characters of the sorted charset want not in the sorted charset c
+func missing(c charset.Charset, want charset.Charset) int {
+	n := 0
+	i := 0
+	for _, r := range want {
+		next := r.Min
+		for next <= r.Max {
+			for i < len(c) && c[i].Max < next {
+				i++
+			}
+			if i == len(c) || c[i].Min > r.Max {
+				n += int(r.Max - next + 1)
+				break
+			}
+			if c[i].Min > next {
+				n += int(c[i].Min - next)
+			}
+			next = c[i].Max + 1
+		}
+	}
+	return n
+}
+
+// covers whether every character in want is in the sorted charset c
+func covers(c charset.Charset, want charset.Charset) bool {
+	return missing(c, want) == 0
+}
+
+// pages the number of 256 character pages c has characters in, fontconfig compares charsets by it
+func pages(c charset.Charset) int {
+	n := 0
+	last := uint64(1) << 32
+	for _, r := range c {
+		first := r.Min >> 8
+		if first == last {
+			first++
+		}
+		if r.Max>>8 >= first {
+			n += int(r.Max>>8 - first + 1)
+		}
+		last = r.Max >> 8
+	}
+	return n
+}
+
+// langs the languages the charset c supports, sorted, with fontconfig's rule: every orthography with fewer
+// than langMissingThreshold characters missing. a font advertising a single CJK language in exclusive
+// only supports the CJK languages with as many pages as that one, like FcFreeTypeLangSet does.
+func langs(c charset.Charset, exclusive string) []string {
+	exclusivePages := -1
+	if orth, ok := orthographyCharsets[exclusive]; ok {
+		exclusivePages = pages(orth)
+	}
+	var l []string
+	for lang, orth := range orthographyCharsets {
+		if exclusivePages >= 0 && isExclusiveLang(lang) && pages(orth) != exclusivePages {
+			continue
+		}
+		if missing(c, orth) < langMissingThreshold {
+			l = append(l, lang)
+		}
+	}
+	sort.Strings(l)
+	return l
+}
+
+// isExclusiveLang whether lang is one of exclusiveLangs
+func isExclusiveLang(lang string) bool {
+	for _, l := range exclusiveLangs {
+		if l == lang {
+			return true
+		}
+	}
+	return false
+}
+
+// Langs the language tags fonts are checked for, sorted
+func Langs() []string {
+	var l []string
+	for lang := range orthographies {
+		l = append(l, lang)
+	}
+	sort.Strings(l)
+	return l
+}
+
+// LangCharset the characters a font must cover to support lang
+func LangCharset(lang string) (charset.Charset, bool) {
+	c, ok := orthographyCharsets[normalizeLang(lang)]
+	return c, ok
+}
@@ -0,0 +1,102 @@
+package font
+
+import (
+	"testing"
+
+	"github.com/marguerite/fonts-config-ng/charset"
+)
+
+func TestOrthographies(t *testing.T) {
+	if n := len(orthographies); n < 240 {
+		t.Errorf("%d orthographies, want every one of fc-lang", n)
+	}
+	// the languages the Noto configuration and the CJK rules rely on
+	for _, lang := range []string{"si", "km", "lo", "my", "te", "ja", "ko", "zh-cn", "zh-tw", "zh-hk", "und-zsye"} {
+		if _, ok := orthographyCharsets[lang]; !ok {
+			t.Errorf("no orthography for %s", lang)
+		}
+	}
+	zsye := orthographyCharsets["und-zsye"]
+	if !covers(zsye, charset.NewCharset("2705 1f300")) {
+		t.Errorf("und-zsye misses emoji outside of the emoticons block: %s", zsye)
+	}
+	ja := orthographyCharsets["ja"]
+	if n := missing(nil, ja); n < 2000 {
+		t.Errorf("ja has %d characters, want the kanji of fontconfig's orthography", n)
+	}
+}
+
+func TestMissing(t *testing.T) {
+	tests := []struct {
+		c, want string
+		missing int
+	}{
+		{"41-5a", "41-5a", 0},
+		{"", "41-5a", 26},
+		{"41-43 45-5a", "41-5a", 1},
+		{"20 40-60", "41-5a 61-7a", 26},
+		{"41 43 45", "41-46", 3},
+		{"30-39 41-46", "35-42 44", 7},
+		{"1f600-1f64f", "1f5ff-1f650", 2},
+	}
+	for _, tt := range tests {
+		if got := missing(charset.NewCharset(tt.c), charset.NewCharset(tt.want)); got != tt.missing {
+			t.Errorf("missing(%q, %q) = %d, want %d", tt.c, tt.want, got, tt.missing)
+		}
+	}
+}
+
+func TestPages(t *testing.T) {
+	tests := []struct {
+		c     string
+		pages int
+	}{
+		{"41-5a", 1},
+		{"41 61 3042", 2},
+		{"ff-100", 2},
+		{"41 4e00-4fff 5001", 4},
+		{"4e00-4eff 4f00", 2},
+	}
+	for _, tt := range tests {
+		if got := pages(charset.NewCharset(tt.c)); got != tt.pages {
+			t.Errorf("pages(%q) = %d, want %d", tt.c, got, tt.pages)
+		}
+	}
+}
+
+func TestLangs(t *testing.T) {
+	has := func(l []string, lang string) bool {
+		for _, v := range l {
+			if v == lang {
+				return true
+			}
+		}
+		return false
+	}
+
+	en := orthographyCharsets["en"]
+	if l := langs(en, ""); !has(l, "en") || has(l, "de") || has(l, "ru") {
+		t.Errorf("the English orthography supports %v", l)
+	}
+
+	// one character short of German
+	de := orthographyCharsets["de"]
+	short := de.Subtract(charset.NewCharset("df"))
+	if has(langs(short, ""), "de") {
+		t.Error("de supported with a character missing")
+	}
+
+	// a font with both Japanese and Korean, advertising Korean only
+	ja := orthographyCharsets["ja"]
+	ko := orthographyCharsets["ko"]
+	both := ja.Union(ko)
+	if l := langs(both, ""); !has(l, "ja") || !has(l, "ko") {
+		t.Errorf("without an exclusive language: %v", l)
+	}
+	if l := langs(both, "ko"); has(l, "ja") || !has(l, "ko") {
+		t.Errorf("exclusive ko: %v", l)
+	}
+	if l := langs(ja, ""); has(l, "zh-tw") || has(l, "zh-cn") {
+		t.Errorf("a Japanese font supports Chinese: %v", l)
+	}
+}
@@ -0,0 +1,189 @@
+//go:build ignore
+// +build ignore
+
+// makeorth generate orthographies.go from the orthographies of fontconfig's fc-lang,
+// the characters a font must have to support every language fontconfig knows
+//
+//	go run makeorth.go -fclang https://gitlab.freedesktop.org/fontconfig/fontconfig/-/raw/2.14.1/fc-lang -output orthographies.go
+//
+// -fclang may also be the fc-lang directory of a fontconfig source tree, it defaults to $FCLANG.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	fclang = flag.String("fclang", os.Getenv("FCLANG"), "URL or directory of fontconfig's fc-lang")
+	output = flag.String("output", "orthographies.go", "the generated file")
+)
+
+// codeRange the code points from lo to hi, inclusive
+type codeRange struct {
+	lo, hi uint64
+}
+
+// remote whether fc-lang is read over http
+func remote() bool {
+	return strings.HasPrefix(*fclang, "http://") || strings.HasPrefix(*fclang, "https://")
+}
+
+// open open a file of fc-lang
+func open(name string) (io.ReadCloser, error) {
+	if remote() {
+		url := strings.TrimSuffix(*fclang, "/") + "/" + name
+		resp, err := http.Get(url)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("can not get %s: %s", url, resp.Status)
+		}
+		return resp.Body, nil
+	}
+	return os.Open(filepath.Join(*fclang, name))
+}
+
+// orthFiles the .orth files of fc-lang, listed by its meson.build when read over http
+func orthFiles() []string {
+	if !remote() {
+		files, err := filepath.Glob(filepath.Join(*fclang, "*.orth"))
+		if err != nil {
+			log.Fatal(err)
+		}
+		for i, f := range files {
+			files[i] = filepath.Base(f)
+		}
+		return files
+	}
+	f, err := open("meson.build")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	b, err := ioutil.ReadAll(f)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var files []string
+	for _, m := range regexp.MustCompile(`'([a-z_]+\.orth)'`).FindAllStringSubmatch(string(b), -1) {
+		files = append(files, m[1])
+	}
+	return files
+}
+
+// parse read the code points and ranges of an .orth file, following its includes like fc-lang does
+func parse(name string, seen map[string]bool) []codeRange {
+	if seen[name] {
+		return nil
+	}
+	seen[name] = true
+
+	f, err := open(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	var ranges []codeRange
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		if strings.HasPrefix(line, "include") {
+			ranges = append(ranges, parse(strings.TrimSpace(strings.TrimPrefix(line, "include")), seen)...)
+			continue
+		}
+		bounds := strings.SplitN(line, "-", 2)
+		lo, err := strconv.ParseUint(strings.TrimPrefix(strings.TrimSpace(bounds[0]), "0x"), 16, 32)
+		if err != nil {
+			log.Fatalf("%s: invalid code point in %q", name, line)
+		}
+		hi, err := strconv.ParseUint(strings.TrimPrefix(strings.TrimSpace(bounds[len(bounds)-1]), "0x"), 16, 32)
+		if err != nil || hi < lo || hi > 0x10ffff {
+			log.Fatalf("%s: invalid code point in %q", name, line)
+		}
+		ranges = append(ranges, codeRange{lo, hi})
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	return ranges
+}
+
+// charsetString sort and merge the ranges, and format them like charset.Charset.String
+func charsetString(ranges []codeRange) string {
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].lo < ranges[j].lo })
+	var merged []codeRange
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && merged[n-1].hi+1 >= r.lo {
+			if r.hi > merged[n-1].hi {
+				merged[n-1].hi = r.hi
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	var s []string
+	for _, r := range merged {
+		if r.lo == r.hi {
+			s = append(s, strconv.FormatUint(r.lo, 16))
+			continue
+		}
+		s = append(s, strconv.FormatUint(r.lo, 16)+"-"+strconv.FormatUint(r.hi, 16))
+	}
+	return strings.Join(s, " ")
+}
+
+func main() {
+	flag.Parse()
+	if len(*fclang) == 0 {
+		log.Fatal("no fc-lang, use -fclang or $FCLANG")
+	}
+
+	files := orthFiles()
+	if len(files) == 0 {
+		log.Fatalf("no .orth file in %s", *fclang)
+	}
+	sort.Strings(files)
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by \"go run makeorth.go\"; DO NOT EDIT.\n\npackage font\n\n")
+	fmt.Fprintf(buf, "// orthographies the characters a font must cover to support a language, from the .orth files of fontconfig's fc-lang\n")
+	fmt.Fprintf(buf, "var orthographies = map[string]string{\n")
+	for _, f := range files {
+		// the language tag is the file name with "-" as separator, like fc-lang does
+		lang := strings.Replace(strings.TrimSuffix(f, ".orth"), "_", "-", -1)
+		fmt.Fprintf(buf, "%q: %q,\n", lang, charsetString(parse(f, make(map[string]bool))))
+	}
+	fmt.Fprintf(buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatalf("can not write %s: %s", *output, err)
+	}
+}
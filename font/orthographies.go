@@ -0,0 +1,253 @@
+// Code generated by "go run makeorth.go"; DO NOT EDIT.
+
+package font
+
+// orthographies the characters a font must cover to support a language, from the .orth files of fontconfig's fc-lang
+var orthographies = map[string]string{
+	"aa":       "41-5a 61-7a c2 ca ce d4 db e2 ea ee f4 fb",
+	"ab":       "401 40f-44f 451 45f 49e-49f 4a6-4a9 4ac-4ad 4b2-4b7 4bc-4bf 4d8-4d9 4e0-4e1",
+	"af":       "41-5a 61-7a c8-cb ce-cf d4 db e8-eb ee-ef f4 fb 149",
+	"ak":       "41-5a 61-7a c3 d1 d5 e3 f1 f5 128-129 168-169 186 190 254 25b 303 306 329 1ebc-1ebd 1ef8-1ef9",
+	"am":       "1200-1206 1208-1216 1218-1226 1228-1230 1238-1246 1248 124a-124d 1260-126e 1270-1286 1288 128a-128d 1290-12ae 12b0 12b2-12b5 12c8-12ce 12d0-12d6 12d8-12ee 12f0-12f7 1300-130e 1310 1312-1315 1320-1346 1348-1356",
+	"an":       "41-5a 61-7a c1 c9 cd d1 d3 da dc e1 e9 ed f1 f3 fa fc",
+	"ar":       "621-63a 641-64a",
+	"as":       "981-983 985-98c 98f-990 993-9a8 9aa-9af 9b2 9b6-9b9 9bc 9be-9c4 9c7-9c8 9cb-9cd 9dc-9dd 9df 9f0-9f1",
+	"ast":      "41-5a 61-7a c1 c9 cd d1 d3 da dc e1 e9 ed f1 f3 fa fc 1e24-1e25 1e36-1e37",
+	"av":       "401 406 410-44f 451",
+	"ay":       "41-5a 61-7a c4 cf d1 dc e4 ef f1 fc",
+	"az-az":    "41-5a 61-7a c7 d6 dc e7 f6 fc 11e-11f 130-131 15e-15f 18f 259",
+	"az-ir":    "621-624 626-63a 641-642 644-648 64b 654 67e 686 698 6a9 6af 6cc",
+	"ba":       "410-44f 492-493 498-499 4a0-4a3 4aa-4ab 4ae-4af 4ba-4bb 4d8-4d9 4e8-4e9",
+	"be":       "406 40e 410-44f 456 45e",
+	"ber-dz":   "41-5a 61-7a 10c-10d 190 194 1e6-1e7 25b 263 1e0c-1e0d 1e24-1e25 1e62-1e63 1e6c-1e6d 1e92-1e93",
+	"ber-ma":   "2d30-2d31 2d33 2d37 2d39 2d3b-2d3d 2d40 2d43-2d45 2d47 2d49-2d4a 2d4d-2d4f 2d53-2d56 2d59-2d5c 2d5f 2d61-2d63 2d65 2d6f",
+	"bg":       "410-42a 42c 42e-44a 44c 44e-44f",
+	"bh":       "905-939 93f-94d",
+	"bho":      "905-939 93f-94d",
+	"bi":       "41-5a 61-7a c9 cf dc e9 ef fc",
+	"bin":      "41-5a 61-7a c0-c1 c8-c9 cc-cd d2-d3 d9-da e0-e1 e8-e9 ec-ed f2-f3 f9-fa 300-301 1eb8-1eb9 1ecc-1ecd",
+	"bm":       "41-5a 61-7a 14a-14b 186 190 19d 254 25b 272",
+	"bn":       "981-983 985-98c 98f-990 993-9a8 9aa-9b0 9b2 9b6-9b9 9bc 9be-9c4 9c7-9c8 9cb-9cd 9dc-9dd 9df",
+	"bo":       "f40-f47 f49-f69 f71-f76 f78 f7a-f7d f80-f81 f90-f97 f99-fb9",
+	"br":       "41-5a 61-7a c2 ca d1 d4 d9 dc e2 ea f1 f4 f9 fc",
+	"brx":      "901-903 905-90c 90f-910 913-928 92a-930 932 935-939 93c-944 947-948 94b-94d 950-952 960-970",
+	"bs":       "41-5a 61-7a 106-107 10c-10d 110-111 160-161 17d-17e",
+	"bua":      "401 410-44f 451 4ae-4af 4ba-4bb",
+	"byn":      "1200-1206 1208-1216 1218-121f 1228-1230 1238-1246 1248 124a-124d 1250-1256 1258 125a-125d 1260-126e 1270-127f 1290-12ae 12b0 12b2-12b5 12c0 12c2-12c5 12c8-12ce 12d0-12d6 12d8-12ee 12f0-12f7 1300-130e 1310 1312-1315 1320-133f 1348-1356",
+	"ca":       "41-5a 61-7a c0 c7-c9 cd cf d2-d3 da dc e0 e7-e9 ed ef f2-f3 fa fc 13f-140",
+	"ce":       "401 406 410-44f 451",
+	"ch":       "41-5a 61-7a c2 d1 dc e2 f1 fc",
+	"chm":      "401 410-44f 451 4a4-4a5 4d2-4d3 4e6-4e7 4f0-4f1 4f8-4f9",
+	"chr":      "13a0-13f4",
+	"co":       "41-5a 61-7a c0 c2 c6-cb ce-cf d4 d9 db-dc e0 e2 e6-eb ee-ef f4 f9 fb-fc ff 152-153 178",
+	"crh":      "41-5a 61-7a c2 c7 d1 d6 dc e2 e7 f1 f6 fc 11e-11f 130-131 15e-15f",
+	"cs":       "41-5a 61-7a c1 c9 cd d3 da dd e1 e9 ed f3 fa fd 10c-10f 11a-11b 147-148 158-159 160-161 164-165 16e-16f 17d-17e",
+	"csb":      "41-5a 61-7a c3 c9 cb d2-d4 d9 e3 e9 eb f2-f4 f9 104-105 141-144 17b-17c",
+	"cu":       "401-402 405-406 408 40b 40d 40f-418 41a-42c 42e-44c 44e-450 452 455-456 458 45b 45d 45f-479",
+	"cv":       "102-103 401 410-44f 451 4aa-4ab 4d6-4d7 4f2-4f3",
+	"cy":       "41-5a 61-7a c2 c9-ca ce-cf d4 e2 e9-ea ee-ef f4 ff 174-178 1e80-1e85 1ef2-1ef3",
+	"da":       "41-5a 61-7a c1 c5-c6 c9 cd d3 d8 da dd e1 e5-e6 e9 ed f3 f8 fa fd",
+	"de":       "41-5a 61-7a c4 d6 dc df e4 f6 fc",
+	"doi":      "902-903 905-90c 90f-910 913-928 92a-930 932 935-939 93c-944 947-948 94b-94d 950-952 95b-96f",
+	"dv":       "780-7b0",
+	"dz":       "f40-f47 f49-f69 f71-f76 f78 f7a-f7d f80-f81 f90-f97 f99-fb9",
+	"ee":       "41-5a 61-7a c0-c1 c8-c9 cc-cd d2-d3 d9-da e0-e1 e8-e9 ec-ed f2-f3 f9-fa 11a-11b 14a-14b 186 189 190-192 194 1b2 1cd-1d4 254 256 25b 263 28b 300-301 30c",
+	"el":       "386 388-38a 38c 38e-3a1 3a3-3ce",
+	"en":       "41-5a 61-7a c0 c7-cb cf d1 d4 d6 e0 e7-eb ef f1 f4 f6",
+	"eo":       "41-5a 61-7a 108-109 11c-11d 124-125 134-135 15c-15d 16c-16d",
+	"es":       "41-5a 61-7a c1 c9 cd d1 d3 da dc e1 e9 ed f1 f3 fa fc",
+	"et":       "41-5a 61-7a c4 d5-d6 dc e4 f5-f6 fc 160-161 17d-17e",
+	"eu":       "41-5a 61-7a d1 dc f1 fc",
+	"fa":       "621-624 626-63a 641-642 644-648 64b 654 67e 686 698 6a9 6af 6cc",
+	"fat":      "41-5a 61-7a c3 d1 d5 e3 f1 f5 128-129 168-169 186 190 254 25b 303 306 329 1ebc-1ebd 1ef8-1ef9",
+	"ff":       "41-5a 61-7a 14a-14b 181 18a 19d 1b3-1b4 253 257 272",
+	"fi":       "41-5a 61-7a c4-c5 d6 e4-e5 f6 160-161 17d-17e",
+	"fil":      "41-5a 61-7a c0-c2 c8-ca cc-ce d1-d4 d9-db e0-e2 e8-ea ec-ee f1-f4 f9-fb",
+	"fj":       "41-5a 61-7a",
+	"fo":       "41-5a 61-7a c1 c6 cd d0 d3 d8 da dd e1 e6 ed f0 f3 f8 fa fd",
+	"fr":       "41-5a 61-7a c0 c2 c6-cb ce-cf d4 d9 db-dc e0 e2 e6-eb ee-ef f4 f9 fb-fc ff 152-153 178",
+	"fur":      "41-5a 61-7a c0-c2 c8 cc d2 d9 e0-e2 e8 ec f2 f9",
+	"fy":       "41-5a 61-7a c2 c4 c9-cb cf d4 d6 da-dc df e2 e4 e9-eb ef f4 f6 fa-fc",
+	"ga":       "41-5a 61-7a c1 c9 cd d3 da e1 e9 ed f3 fa 10a-10b 120-121 1e02-1e03 1e0a-1e0b 1e1e-1e1f 1e40-1e41 1e56-1e57 1e60-1e61 1e6a-1e6b",
+	"gd":       "41-5a 61-7a c0-c1 c7-c9 cc d2-d3 d9 e0-e1 e7-e9 ec f2-f3 f9",
+	"gez":      "1200-1206 1208-1216 1218-1226 1228-1230 1238-1246 1248 124a-124d 1260-1267 1270-1277 1280-1286 1288 128a-128d 1290-1297 12a0-12ae 12b0 12b2-12b5 12c8-12ce 12d0-12d6 12d8-12df 12e8-12ee 12f0-12f7 1308-130e 1310 1312-1315 1320-1328 1330-1346 1348-1356",
+	"gl":       "41-5a 61-7a c1 c9 cd d1 d3 da dc e1 e9 ed f1 f3 fa fc",
+	"gn":       "41-5a 61-7a c1 c9 cd d1 d3 da e1 e3 e9 ed f1 f3 f5 fa 129 169 1ebd 1ef9",
+	"gu":       "a81-a83 a85-a8b a8d a8f-a91 a93-aa8 aaa-ab0 ab2-ab3 ab5-ab9 abc-ac5 ac7-ac9 acb-acd ad0 ae0",
+	"gv":       "41-5a 61-7a c7 e7",
+	"ha":       "41-5a 61-7a 181 18a 198-199 1b3-1b4 253 257",
+	"haw":      "41-5a 61-7a 100-101 112-113 12a-12b 14c-14d 16a-16b 2bb",
+	"he":       "5d0-5ea",
+	"hi":       "905-939 93f-94d",
+	"hne":      "905-939 93f-94d",
+	"ho":       "41-5a 61-7a",
+	"hr":       "41-5a 61-7a 106-107 10c-10d 110-111 160-161 17d-17e",
+	"hsb":      "41-5a 61-7a d3 f3 106-107 10c-10d 11a-11b 141-144 158-159 160-161 179-17a 17d-17e",
+	"ht":       "41-5a 61-7a c8 d2 e8 f2",
+	"hu":       "41-5a 61-7a c1 c9 cd d3 d6 da dc e1 e9 ed f3 f6 fa fc 150-151 170-171",
+	"hy":       "531-556 561-587",
+	"hz":       "41-5a 61-7a 32f 1e12-1e13 1e4a-1e4b",
+	"ia":       "41-5a 61-7a",
+	"id":       "41-5a 61-7a c9 e9",
+	"ie":       "41-5a 61-7a c1 c9 cd d3 da dd e1 e9 ed f3 fa fd",
+	"ig":       "41-5a 61-7a 1eca-1ecd 1ee4-1ee5",
+	"ii":       "a000-a48c",
+	"ik":       "401 40e 410-44f 451 45e",
+	"io":       "41-5a 61-7a",
+	"is":       "41-5a 61-7a c1 c6 c9 cd d0 d3 da dd-de e1 e6 e9 ed f0 f3 fa fd-fe",
+	"it":       "41-5a 61-7a c0 c8-c9 cc-cd cf d2-d3 d9-da e0 e8-e9 ec-ed ef f2-f3 f9-fa",
+	"iu":       "1401-1406 140a-140b 142f-1434 1438-1439 1449 144c-1451 1455-1456 1466 146b-1470 1472-1473 1483 1489-148e 1490-1491 14a1 14a3-14a8 14aa-14ab 14bb 14c0-14c5 14c7-14c8 14d0 14d3-14d8 14da-14db 14ea 14ed-14f2 14f4-14f5 14fa 14fc 14fe 1500 1502 1505 1526-152b 152d-152e 153e 1542 1545-1549 154b-154c 1550 1553-155a 155d 1575-1577 1579-157c 157e-1585 158b-1596 15a0-15a6 15a8-15ae 166f-1676",
+	"ja":       "3000-3002 3005 3007 3041-3093 309b-309e 30a1-30f6 30fb-30fe 4e00-4e01 4e03 4e07-4e0b 4e0d-4e0e 4e14 4e16 4e18-4e19 4e21 4e26 4e2d 4e32 4e38-4e39 4e3b-4e3c 4e45 4e4f 4e57 4e59 4e5d-4e5e 4e71 4e73 4e7e 4e80 4e86 4e88-4e89 4e8b-4e8c 4e92 4e94-4e95 4e9c 4ea1 4ea4 4eab-4ead 4eba 4ec1 4eca-4ecb 4ecf 4ed5-4ed6 4ed8-4ed9 4ee3-4ee5 4eee 4ef0 4ef2 4ef6 4efb 4f01 4f0e-4f11 4f1a 4f1d 4f2f 4f34 4f38 4f3a 4f3c 4f46 4f4d-4f50 4f53 4f55 4f59 4f5c 4f73 4f75 4f7f 4f8b 4f8d 4f9b 4f9d 4fa1 4fae-4faf 4fb5-4fb6 4fbf 4fc2-4fc3 4fca 4fd7 4fdd 4fe1 4fee 4ff3 4ff5 4ff8 4ffa 5009 500b 500d 5012 5019 501f 5023-5024 502b 5039 5049 504f 505c 5065 5074-5076 507d 508d 5091 5098-5099 50ac 50b2 50b5 50b7 50be 50c5 50cd 50cf 50d5 50da 50e7 5100 5104 5112 511f 512a 5143-5146 5148-5149 514b 514d 5150 515a 5165 5168 516b-516d 5171 5175 5177-5178 517c 5185-5186 518a 518d 5192 5197 5199 51a0 51a5 51ac 51b6-51b7 51c4 51c6 51cd 51dd 51e1 51e6 51f6 51f8-51fa 5200 5203 5206-5208 520a 5211 5217 521d 5224-5225 5229 5230 5236-523b 5247 524a 524d 5256 525b 5263-5264 526f-5270 5272 5275 5287 529b 529f-52a0 52a3 52a9-52aa 52b1 52b4 52b9 52be 52c3 52c5 52c7 52c9 52d5 52d8-52d9 52dd 52df 52e2 52e4 52e7 52f2 52fe 5302 5305 5316-5317 5320 5339-533b 533f 5341 5343 5347-5348 534a 5351-5354 5357-5358 535a 5360 5370-5371 5373-5375 5378 5384 5398 539a 539f 53b3 53bb 53c2 53c8 53ca-53ce 53d4 53d6-53d7 53d9 53e3-53e5 53eb-53ec 53ef-53f0 53f2-53f3 53f7-53f8 5404 5408-5409 540c-5411 541b 541f 5426 542b 5438-5439 5442 5448-544a 5468 546a 5473 547c-547d 548c 54b2 54bd 54c0-54c1 54e1 54f2 54fa 5504 5506-5507 5510 552f 5531 553e 5546 554f 5553 5584 5589 559a 559c-559d 55a9-55ab 55b6 55c5 55e3 5606 5631-5632 5668 5674 5687 56da-56db 56de 56e0 56e3 56f0 56f2-56f3 56fa 56fd 570f 5712 571f 5727-5728 5730 5742 5747 574a 5751 576a 5782 578b 57a3 57cb 57ce 57df 57f7 57f9-57fa 57fc 5800 5802 5805-5806 5815 5824 582a 5831 5834 5840-5841 584a 5851 5854 5857 585a 585e 5869 587e 5883 5893 5897 589c 58a8 58b3 58be 58c1 58c7 58ca 58cc 58eb 58ee 58f0-58f2 5909 590f 5915-5916 591a 591c 5922 5927 5929-592b 592e 5931 5947-5949 594f 5951 5954 5965 5968 596a 596e 5973-5974 597d 5982-5984 598a 5996 5999 59a5 59a8 59ac 59b9 59bb 59c9 59cb 59d3-59d4 59eb 59fb 59ff 5a01 5a18 5a20 5a2f 5a46 5a5a 5a66 5a7f 5a92 5a9b 5ac1 5ac9 5acc 5ae1 5b22 5b50 5b54 5b57-5b58 5b5d 5b63-5b64 5b66 5b6b 5b85 5b87-5b89 5b8c 5b97-5b9d 5b9f 5ba2-5ba4 5bae 5bb0 5bb3-5bb6 5bb9 5bbf 5bc2 5bc4 5bc6 5bcc 5bd2 5bdb 5bdd 5bdf 5be1 5be7 5be9 5bee 5bf8 5bfa 5bfe-5bff 5c01-5c02 5c04 5c06 5c09-5c0b 5c0e-5c0f 5c11 5c1a 5c31 5c3a-5c40 5c45 5c48 5c4a-5c4b 5c55 5c5e 5c64-5c65 5c6f 5c71 5c90 5ca1 5ca9 5cac 5cb3 5cb8 5ce0-5ce1 5cf0 5cf6 5d07 5d0e 5d16 5d29 5d50 5ddd-5dde 5de1 5de3 5de5-5de8 5dee 5df1 5dfb 5dfe 5e02-5e03 5e06 5e0c 5e1d 5e25 5e2b 5e2d 5e2f-5e30 5e33 5e38 5e3d 5e45 5e55 5e63 5e72-5e74 5e78-5e79 5e7b-5e7e 5e81 5e83 5e8a 5e8f 5e95 5e97 5e9c 5ea6-5ea7 5eab 5ead 5eb6-5eb8 5ec3 5ec9-5eca 5ef6-5ef7 5efa 5f01 5f04 5f0a 5f0f-5f10 5f13-5f15 5f1f 5f25-5f27 5f31 5f35 5f37 5f3e 5f53 5f59 5f62 5f69 5f6b 5f70-5f71 5f79 5f7c 5f80-5f81 5f84-5f85 5f8b-5f8c 5f90 5f92-5f93 5f97 5fa1 5fa9-5faa 5fae 5fb3-5fb4 5fb9 5fc3 5fc5 5fcc-5fcd 5fd7-5fd9 5fdc 5fe0 5feb 5ff5 6012 6016 601d 6020 6025 6027-6028 602a 604b 6050 6052 6063 6065 6068-6069 606d 606f 6075 6094 609f-60a0 60a3 60a6 60a9-60aa 60b2 60bc 60c5 60d1 60dc 60e7-60e8 60f0 60f3 6101 6109 610f 611a-611b 611f 6144 6148 614b-614c 614e 6155 6162-6163 6168 616e 6170 6176 6182 618e 61a4 61a7 61a9 61ac 61b2 61b6 61be 61c7 61d0 61f2 61f8 6210-6212 621a 6226 622f 6234 6238 623b 623f-6240 6247 6249 624b 624d 6253 6255 6271 6276 6279 627f-6280 6284 628a 6291 6295 6297-6298 629c 629e 62ab 62b1 62b5 62b9 62bc-62bd 62c5 62c9 62cd 62d0 62d2-62d3 62d8-62d9 62db 62dd 62e0-62e1 62ec-62ed 62f3 62f6-62f7 62fe 6301 6307 6311 6319 631f 6328 632b 632f 633f 6349 6355 6357 635c 6368 636e 637b 6383 6388 638c 6392 6398 639b 63a1-63a2 63a5 63a7-63a8 63aa 63b2 63cf-63d0 63da-63db 63e1 63ee 63f4 63fa 640d 642c-642d 643a 643e 6442 6458 6469 646f 6483 64a4 64ae 64b2 64c1 64cd 64e6 64ec 652f 6539 653b 653e-653f 6545 654f 6551 6557 6559 6562-6563 656c 6570 6574-6575 6577 6587 6589 658e 6591 6597 6599 659c 65a4-65a5 65ac-65ad 65b0 65b9 65bd 65c5 65cb 65cf 65d7 65e2 65e5-65e9 65ec 65fa 6606-6607 660e 6613-6614 661f-6620 6625 6627-6628 662d 662f 663c 6642 6669 666e-666f 6674 6676 6681 6687 6691 6696-6697 66a6 66ab 66ae 66b4 66c7 66d6 66dc 66f2 66f4 66f8-66f9 66fd 66ff-6700 6708-6709 670d 6715 6717 671b 671d 671f 6728 672a-672d 6731 6734 673a 673d 6749 6750-6751 675f 6761 6765 676f 6771 677e-677f 6790 6795 6797 679a 679c-679d 67a0 67a2 67af 67b6 67c4 67d0 67d3-67d4 67f1 67f3 67f5 67fb 67ff 6803-6804 6813 6821 682a 6838-6839 683c-683d 6841 6843 6848 6851 685c 685f 6885 6897 68a8 68b0 68c4 68cb 68d2 68da 68df 68ee 68fa 6905 690d-690e 691c 696d 6975 6977 697c-697d 6982 69cb 69d8 69fd 6a19 6a21 6a29-6a2a 6a39 6a4b 6a5f 6b04 6b20-6b21 6b27 6b32 6b3a 6b3e 6b4c 6b53 6b62-6b63 6b66 6b69 6b6f 6b73-6b74 6b7b 6b89-6b8b 6b96 6bb4-6bb5 6bba-6bbb 6bbf-6bc0 6bcd-6bce 6bd2 6bd4 6bdb 6c0f 6c11 6c17 6c34 6c37-6c38 6c3e 6c41-6c42 6c4e 6c57 6c5a 6c5f-6c60 6c70 6c7a 6c7d 6c83 6c88 6c96 6c99 6ca1-6ca2 6cb3 6cb8-6cb9 6cbb-6cbc 6cbf 6cc1 6cc9-6cca 6ccc 6cd5 6ce1-6ce3 6ce5 6ce8 6cf0 6cf3 6d0b 6d17 6d1e 6d25 6d2a 6d3b 6d3e 6d41 6d44-6d45 6d5c 6d66 6d6a 6d6e 6d74 6d77-6d78 6d88 6d99 6daf 6db2 6dbc 6dd1 6de1 6deb 6df1 6df7 6dfb 6e05 6e07-6e09 6e0b 6e13 6e1b 6e21 6e26 6e29 6e2c 6e2f 6e56 6e67 6e6f 6e7e-6e80 6e90 6e96 6e9d 6eb6 6eba 6ec5 6ecb 6ed1 6edd-6ede 6ef4 6f01-6f02 6f06 6f0f 6f14 6f20 6f22 6f2b-6f2c 6f38 6f54 6f5c 6f5f 6f64 6f6e 6f70 6f84 6fc0-6fc1 6fc3 6feb 6fef 702c 706b 706f-7070 707d 7089-708a 708e 70ad 70b9-70ba 70c8 7121 7126 7136 713c 714e 7159 7167 7169 716e 718a 719f 71b1 71c3 71e5 7206 722a 7235-7236 723d 7247-7248 7259 725b 7267 7269 7272 7279 72a0 72ac 72af 72b6 72c2 72d9 72e9 72ec-72ed 731b 731f 732b 732e 7336 733f 7344 7363 7372 7384 7387 7389 738b 73a9 73cd 73e0 73ed 73fe 7403 7406 7434 7460 7483 74a7 74b0 74bd 74e6 74f6 7518 751a 751f 7523 7528 7530-7533 7537 753a-753b 754c 754f 7551 7554 7559 755c-755d 7565 756a 7570 7573 757f 758e 7591 75ab 75b2 75be 75c5 75c7 75d5 75d8 75db 75e2 75e9 75f4 760d 7642 7652 7656 767a-767b 767d-767e 7684 7686-7687 76ae 76bf 76c6 76ca 76d7 76db 76df 76e3-76e4 76ee 76f2 76f4 76f8 76fe 7701 7709 770b-770c 771f-7720 773a 773c 7740 7761 7763 7766 77ac-77ad 77b3 77db 77e2 77e5 77ed 77ef 77f3 7802 7814-7815 7832 7834 785d 786b-786c 7881 7891 78ba 78c1 78e8 7901 790e 793a 793c 793e 7948-7949 7956 795d-795e 7965 7968 796d 7981 7985 798d 798f 79c0-79c1 79cb 79d1-79d2 79d8 79df 79e9 79f0 79fb 7a0b 7a0e 7a1a 7a2e 7a32 7a3c-7a3d 7a3f-7a40 7a42 7a4d 7a4f 7a6b 7a74 7a76 7a7a 7a81 7a83 7a92-7a93 7a9f 7aae-7aaf 7acb 7adc 7ae0 7ae5 7aef 7af6 7af9 7b11 7b1b 7b26 7b2c 7b46 7b49 7b4b 7b52 7b54 7b56 7b87 7b8b 7b97 7ba1 7bb1 7bb8 7bc0 7bc4 7bc9 7be4 7c21 7c3f 7c4d 7c60 7c73 7c89 7c8b 7c92 7c97-7c98 7c9b 7ca7 7cbe 7cd6 7ce7 7cf8 7cfb 7cfe 7d00 7d04-7d05 7d0b 7d0d 7d14 7d19-7d1b 7d20-7d22 7d2b 7d2f-7d30 7d33 7d39-7d3a 7d42 7d44 7d4c 7d50 7d5e 7d61 7d66 7d71 7d75-7d76 7d79 7d99-7d9a 7dad 7db1-7db2 7dbb 7dbf 7dca 7dcf 7dd1-7dd2 7dda 7de0 7de8-7de9 7def 7df4 7dfb 7e01 7e04 7e1b 7e26 7e2b 7e2e 7e3e 7e41 7e4a 7e54-7e55 7e6d 7e70 7f36 7f6a 7f6e 7f70 7f72 7f75 7f77 7f85 7f8a 7f8e 7f9e 7fa4 7fa8-7fa9 7fbd 7fc1 7fcc 7fd2 7ffb-7ffc 8001 8003 8005 8010 8015 8017 8033 8056 805e 8074 8077 8089 808c 8096 8098 809d 80a1-80a2 80a5 80a9-80aa 80af 80b2 80ba 80c3 80c6 80cc 80ce 80de 80f4 80f8 80fd 8102 8105 8107-8108 810a 811a 8131 8133 814e 8150 8155 816b 8170 8178-817a 819a 819c-819d 81a8 81b3 81c6 81d3 81e3 81e8 81ea 81ed 81f3-81f4 81fc 8208 820c 820e 8217 821e-821f 822a 822c 8236-8237 8239 8247 8266 826f 8272 8276 828b 829d 82af 82b1 82b3 82b8 82bd 82d7 82db 82e5-82e6 82f1 8302 830e 8328 8336 8349 8352 8358 8377 83ca 83cc 83d3 83dc 83ef 840e 843d 8449 8457 845b 846c 84b8 84c4 84cb 8511 8535 853d 8584 85a6 85aa-85ac 85cd 85e4 85e9 85fb 864e 8650 865a 865c 865e 866b 8679 868a 8695 86c7 86cd 86ee 8702 871c 878d 8840 8846 884c 8853 8857 885b 885d 8861 8863 8868 8870 8877 888b 8896 88ab 88c1-88c2 88c5 88cf 88d5 88dc 88f8 88fd-88fe 8907 8910 8912 895f 8972 897f 8981 8986-8987 898b 898f 8996 899a 89a7 89aa 89b3 89d2 89e3 89e6 8a00 8a02-8a03 8a08 8a0e 8a13 8a17-8a18 8a1f 8a2a 8a2d 8a31 8a33-8a34 8a3a 8a3c 8a50 8a54-8a55 8a5e 8a60 8a63 8a66 8a69 8a6e 8a70-8a73 8a87 8a89 8a8c-8a8d 8a93 8a95 8a98 8a9e 8aa0 8aa4 8aac-8aad 8ab0 8ab2 8abf 8ac7 8acb 8ad6 8ae6-8ae7 8aed-8aee 8af8 8afe 8b00-8b01 8b04 8b0e 8b19 8b1b 8b1d 8b21 8b39 8b58 8b5c 8b66 8b70 8b72 8b77 8c37 8c46 8c4a 8c5a 8c61 8c6a 8c8c 8c9d-8c9e 8ca0-8ca2 8ca7-8cac 8caf 8cb4 8cb7-8cb8 8cbb-8cbc 8cbf-8cc0 8cc2-8cc4 8cc7 8cca 8cd3 8cdb-8cdc 8cde 8ce0 8ce2 8ce6 8cea 8ced 8cfc 8d08 8d64 8d66 8d70 8d74 8d77 8d85 8d8a 8da3 8db3 8ddd 8de1 8def 8df3 8df5 8e0a 8e0f 8e2a 8e74 8e8d 8eab 8eca 8ecc-8ecd 8ed2 8edf 8ee2 8ef8 8efd 8f03 8f09 8f1d 8f29-8f2a 8f38 8f44 8f9b 8f9e 8fa3 8fb1-8fb2 8fba 8fbc 8fc5 8fce 8fd1 8fd4 8feb 8fed 8ff0 8ff7 8ffd 9000-9001 9003 9006 900f-9010 9013-9014 901a 901d 901f-9020 9023 902e 9031-9032 9038 9042 9045 9047 904a-904b 904d-904e 9053-9055 905c 9060-9061 9063 9069 906d-906e 9075 9077-9078 907a 907f 9084 90a3 90a6 90aa 90b8 90ca 90ce 90e1 90e8 90ed 90f5 90f7 90fd 914c-914e 9152 9154 9162 916a 916c 9175 9177-9178 9192 919c 91b8 91c7-91c8 91cc-91cf 91d1 91dc-91dd 91e3 920d 9234 9244 925b 9262 9271 9280 9283 9285 9298 92ad 92ed 92f3 92fc 9320 9326 932c 932e-932f 9332 934b 935b 9375 938c 9396 93ae 93e1 9418 9451 9577 9580 9589 958b 9591 9593 95a2-95a3 95a5 95b2 95c7 95d8 961c 962a 9632 963b 9644 964d 9650 965b 9662-9665 966a 9670 9673 9675-9676 9678 967a 967d 9685-9686 968a 968e-968f 9694 9699 969b-969c 96a0 96a3 96b7 96bb 96c4-96c7 96cc 96d1 96e2-96e3 96e8 96ea 96f0 96f2 96f6-96f7 96fb 9700 9707 970a 971c 9727 9732 9752 9759 975e 9762 9769 9774 97d3 97f3 97fb 97ff 9802-9803 9805-9806 9808 9810-9813 9818 982d 983b-983c 984c-984e 9854-9855 9858 985e 9867 98a8 98db 98df 98e2 98ef 98f2 98fc-98fe 9905 990a 990c 9913 9928 9996 9999 99ac 99c4-99c6 99d0 99d2 9a0e 9a12-9a13 9a30 9a5a 9aa8 9ab8 9ac4 9ad8 9aea 9b31 9b3c 9b42 9b45 9b54 9b5a 9bae 9be8 9ce5 9cf4 9d8f 9db4 9e7f 9e93 9e97 9ea6 9eba-9ebb 9ec4 9ed2 9ed9 9f13 9f3b 9f62",
+	"jv":       "41-5a 61-7a c8-c9 e8-e9",
+	"ka":       "10d0-10f0",
+	"kaa":      "401 410-44f 451 492-493 49a-49b 4a2-4a3 4ae-4af 4b2-4b3 4d8-4d9",
+	"kab":      "41-5a 61-7a 10c-10d 190 194 1e6-1e7 25b 263 1e0c-1e0d 1e24-1e25 1e62-1e63 1e6c-1e6d 1e92-1e93",
+	"ki":       "41-5a 61-7a 128-129 168-169",
+	"kj":       "41-5a 61-7a",
+	"kk":       "410-44f 456 492-493 49a-49b 4a2-4a3 4ba-4bb 4d8-4d9 4e8-4e9",
+	"kl":       "41-5a 61-7a c1-c3 c5-c6 ca cd-ce d4 d8 da-db e1-e3 e5-e6 ea ed-ee f4 f8 fa-fb 128-129 138 168-169",
+	"km":       "1780-179c 179f-17a2 17a5-17a7 17a9-17b3 17b6-17c5",
+	"kn":       "c82-c83 c85-c8c c8e-c90 c92-ca8 caa-cb3 cb5-cb9 cbe-cc4 cc6-cc8 cca-ccd cd5-cd6 cde ce0-ce1",
+	"ko":       "3131-3163 3165-318e ac00-ac01 ac04 ac07-ac0a ac10-ac17 ac19-ac1d ac20 ac24 ac2c-ac2d ac2f-ac31 ac38-ac39 ac3c ac40 ac4b ac4d ac54 ac58 ac5c ac70-ac71 ac74 ac77-ac78 ac7a ac80-ac81 ac83-ac86 ac89-ac8c ac90 ac94 ac9c-ac9d ac9f-aca1 aca8-acaa acac acaf-acb0 acb8-acb9 acbb-acbd acc1 acc4 acc8 accc acd5 acd7 ace0-ace1 ace4 ace7-ace8 acea acec acef-acf1 acf3 acf5-acf6 acfc-acfd ad00 ad04 ad06 ad0c-ad0d ad0f ad11 ad18 ad1c ad20 ad29 ad2c-ad2d ad34-ad35 ad38 ad3c ad44-ad45 ad47 ad49 ad50 ad54 ad58 ad61 ad63 ad6c-ad6d ad70 ad73-ad76 ad7b-ad7d ad7f ad81-ad82 ad88-ad89 ad8c ad90 ad9c-ad9d ada4 adb7 adc0-adc1 adc4 adc8 add0-add1 add3 addc ade0 ade4 adf8-adf9 adfc adff-ae01 ae08-ae09 ae0b ae0d ae14 ae30-ae31 ae34 ae37-ae38 ae3a ae40-ae41 ae43 ae45-ae46 ae4a ae4c-ae4e ae50 ae54 ae56 ae5c-ae5d ae5f-ae61 ae65 ae68-ae69 ae6c ae70 ae78-ae79 ae7b-ae7d ae84-ae85 ae8c aebc-aebe aec0 aec4 aecc-aecd aecf-aed1 aed8-aed9 aedc aee8 aeeb aeed aef4 aef8 aefc af07-af08 af0d af10 af2c-af2d af30 af32 af34 af3c-af3d af3f af41-af43 af48-af49 af50 af5c-af5d af64-af65 af79 af80 af84 af88 af90-af91 af95 af9c afb8-afb9 afbc afc0 afc7-afc9 afcb afcd-afce afd4 afdc afe8-afe9 aff0-aff1 aff4 aff8 b000-b001 b004 b00c b010 b014 b01c-b01d b028 b044-b045 b048 b04a b04c b04e b053-b055 b057 b059 b05d b07c-b07d b080 b084 b08c-b08d b08f b091 b098-b09a b09c b09f-b0a2 b0a8-b0a9 b0ab-b0af b0b1 b0b3-b0b5 b0b8 b0bc b0c4-b0c5 b0c7-b0c9 b0d0-b0d1 b0d4 b0d8 b0e0 b0e5 b108-b109 b10b-b10c b110 b112-b113 b118-b119 b11b-b11d b123-b125 b128 b12c b134-b135 b137-b139 b140-b141 b144 b148 b150-b151 b154-b155 b158 b15c b160 b178-b179 b17c b180 b182 b188-b189 b18b b18d b192-b194 b198 b19c b1a8 b1cc b1d0 b1d4 b1dc-b1dd b1df b1e8-b1e9 b1ec b1f0 b1f9 b1fb b1fd b204-b205 b208 b20b-b20c b214-b215 b217 b219 b220 b234 b23c b258 b25c b260 b268-b269 b274-b275 b27c b284-b285 b289 b290-b291 b294 b298-b29a b2a0-b2a1 b2a3 b2a5-b2a6 b2aa b2ac b2b0 b2b4 b2c8-b2c9 b2cc b2d0 b2d2 b2d8-b2d9 b2db b2dd b2e2 b2e4-b2e6 b2e8 b2eb-b2ef b2f3-b2f5 b2f7-b2fb b2ff-b301 b304 b308 b310-b311 b313-b315 b31c b354-b356 b358 b35b-b35c b35e-b35f b364-b365 b367 b369 b36b b36e b370-b371 b374 b378 b380-b381 b383-b385 b38c b390 b394 b3a0-b3a1 b3a8 b3ac b3c4-b3c5 b3c8 b3cb-b3cc b3ce b3d0 b3d4-b3d5 b3d7 b3d9 b3db b3dd b3e0 b3e4 b3e8 b3fc b410 b418 b41c b420 b428-b429 b42b b434 b450-b451 b454 b458 b460-b461 b463 b465 b46c b480 b488 b49d b4a4 b4a8 b4ac b4b5 b4b7 b4b9 b4c0 b4c4 b4c8 b4d0 b4d5 b4dc-b4dd b4e0 b4e3-b4e4 b4e6 b4ec-b4ed b4ef b4f1 b4f8 b514-b515 b518 b51b-b51c b524-b525 b527-b52a b530-b531 b534 b538 b540-b541 b543-b545 b54b-b54d b550 b554 b55c-b55d b55f-b561 b5a0-b5a1 b5a4 b5a8 b5aa-b5ab b5b0-b5b1 b5b3-b5b5 b5bb-b5bd b5c0 b5c4 b5cc-b5cd b5cf-b5d1 b5d8 b5ec b610-b611 b614 b618 b625 b62c b634 b648 b664 b668 b69c-b69d b6a0 b6a4 b6ab-b6ac b6b1 b6d4 b6f0 b6f4 b6f8 b700-b701 b705 b728-b729 b72c b72f-b730 b738-b739 b73b b744 b748 b74c b754-b755 b760 b764 b768 b770-b771 b773 b775 b77c-b77d b780 b784 b78c-b78d b78f-b792 b796-b799 b79c b7a0 b7a8-b7a9 b7ab-b7ad b7b4-b7b5 b7b8 b7c7 b7c9 b7ec-b7ed b7f0 b7f4 b7fc-b7fd b7ff-b801 b807-b809 b80c b810 b818-b819 b81b b81d b824-b825 b828 b82c b834-b835 b837-b839 b840 b844 b851 b853 b85c-b85d b860 b864 b86c-b86d b86f b871 b878 b87c b88d b8a8 b8b0 b8b4 b8b8 b8c0-b8c1 b8c3 b8c5 b8cc b8d0 b8d4 b8dd b8df b8e1 b8e8-b8e9 b8ec b8f0 b8f8-b8f9 b8fb b8fd b904 b918 b920 b93c-b93d b940 b944 b94c b94f b951 b958-b959 b95c b960 b968-b969 b96b b96d b974-b975 b978 b97c b984-b985 b987 b989-b98a b98d-b98e b9ac-b9ad b9b0 b9b4 b9bc-b9bd b9bf b9c1 b9c8-b9c9 b9cc b9ce-b9d2 b9d8-b9d9 b9db b9dd-b9de b9e1 b9e3-b9e5 b9e8 b9ec b9f4-b9f5 b9f7-b9fa ba00-ba01 ba08 ba15 ba38-ba39 ba3c ba40 ba42 ba48-ba49 ba4b ba4d-ba4e ba53-ba55 ba58 ba5c ba64-ba65 ba67-ba69 ba70-ba71 ba74 ba78 ba83-ba85 ba87 ba8c baa8-baa9 baab-baac bab0 bab2 bab8-bab9 babb babd bac4 bac8 bad8-bad9 bafc bb00 bb04 bb0d bb0f bb11 bb18 bb1c bb20 bb29 bb2b bb34-bb36 bb38 bb3b-bb3e bb44-bb45 bb47 bb49 bb4d bb4f-bb50 bb54 bb58 bb61 bb63 bb6c bb88 bb8c bb90 bba4 bba8 bbac bbb4 bbb7 bbc0 bbc4 bbc8 bbd0 bbd3 bbf8-bbf9 bbfc bbff-bc00 bc02 bc08-bc09 bc0b-bc0d bc0f bc11 bc14-bc18 bc1b-bc1f bc24-bc25 bc27 bc29 bc2d bc30-bc31 bc34 bc38 bc40-bc41 bc43-bc45 bc49 bc4c-bc4d bc50 bc5d bc84-bc85 bc88 bc8b-bc8c bc8e bc94-bc95 bc97 bc99-bc9a bca0-bca1 bca4 bca7-bca8 bcb0-bcb1 bcb3-bcb5 bcbc-bcbd bcc0 bcc4 bccd bccf-bcd1 bcd5 bcd8 bcdc bcf4-bcf6 bcf8 bcfc bd04-bd05 bd07 bd09 bd10 bd14 bd24 bd2c bd40 bd48-bd49 bd4c bd50 bd58-bd59 bd64 bd68 bd80-bd81 bd84 bd87-bd8a bd90-bd91 bd93 bd95 bd99-bd9a bd9c bda4 bdb0 bdb8 bdd4-bdd5 bdd8 bddc bde9 bdf0 bdf4 bdf8 be00 be03 be05 be0c-be0d be10 be14 be1c-be1d be1f be44-be45 be48 be4c be4e be54-be55 be57 be59-be5b be60-be61 be64 be68 be6a be70-be71 be73-be75 be7b-be7d be80 be84 be8c-be8d be8f-be91 be98-be99 bea8 bed0-bed1 bed4 bed7-bed8 bee0 bee3-bee5 beec bf01 bf08-bf09 bf18-bf19 bf1b-bf1d bf40-bf41 bf44 bf48 bf50-bf51 bf55 bf94 bfb0 bfc5 bfcc-bfcd bfd0 bfd4 bfdc bfdf bfe1 c03c c051 c058 c05c c060 c068-c069 c090-c091 c094 c098 c0a0-c0a1 c0a3 c0a5 c0ac-c0ad c0af-c0b0 c0b3-c0b6 c0bc-c0bd c0bf-c0c1 c0c5 c0c8-c0c9 c0cc c0d0 c0d8-c0d9 c0db-c0dd c0e4-c0e5 c0e8 c0ec c0f4-c0f5 c0f7 c0f9 c100 c104 c108 c110 c115 c11c-c120 c123-c124 c126-c127 c12c-c12d c12f-c131 c136 c138-c139 c13c c140 c148-c149 c14b-c14d c154-c155 c158 c15c c164-c165 c167-c169 c170 c174 c178 c185 c18c-c18e c190 c194 c196 c19c-c19d c19f c1a1 c1a5 c1a8-c1a9 c1ac c1b0 c1bd c1c4 c1c8 c1cc c1d4 c1d7-c1d8 c1e0 c1e4 c1e8 c1f0-c1f1 c1f3 c1fc-c1fd c200 c204 c20c-c20d c20f c211 c218-c219 c21c c21f-c220 c228-c229 c22b c22d c22f c231-c232 c234 c248 c250-c251 c254 c258 c260 c265 c26c-c26d c270 c274 c27c-c27d c27f c281 c288-c289 c290 c298 c29b c29d c2a4-c2a5 c2a8 c2ac-c2ad c2b4-c2b5 c2b7 c2b9 c2dc-c2dd c2e0 c2e3-c2e4 c2eb-c2ed c2ef c2f1 c2f6 c2f8-c2f9 c2fb-c2fc c300 c308-c309 c30c-c30d c313-c315 c318 c31c c324-c325 c328-c329 c345 c368-c369 c36c c370 c372 c378-c379 c37c-c37d c384 c388 c38c c3d8-c3d9 c3dc c3df-c3e0 c3e2 c3e8-c3e9 c3ed c3f4-c3f5 c3f8 c408 c410 c424 c42c c430 c434 c43c-c43d c448 c464-c465 c468 c46c c474-c475 c479 c480 c494 c49c c4b8 c4bc c4e9 c4f0-c4f1 c4f4 c4f8 c4fa c4ff-c501 c50c c510 c514 c51c c528-c529 c52c c530 c538-c539 c53b c53d c544-c545 c548-c54a c54c-c54e c553-c555 c557-c559 c55d-c55e c560-c561 c564 c568 c570-c571 c573-c575 c57c-c57d c580 c584 c587 c58c-c58d c58f c591 c595 c597-c598 c59c c5a0 c5a9 c5b4-c5b5 c5b8-c5b9 c5bb-c5be c5c4-c5ca c5cc c5ce c5d0-c5d1 c5d4 c5d8 c5e0-c5e1 c5e3 c5e5 c5ec-c5ee c5f0 c5f4 c5f6-c5f7 c5fc-c601 c605-c608 c60c c610 c618-c619 c61b-c61c c624-c625 c628 c62c-c62e c630 c633-c635 c637 c639 c63b c640-c641 c644 c648 c650-c651 c653-c655 c65c-c65d c660 c66c c66f c671 c678-c679 c67c c680 c688-c689 c68b c68d c694-c695 c698 c69c c6a4-c6a5 c6a7 c6a9 c6b0-c6b1 c6b4 c6b8-c6ba c6c0-c6c1 c6c3 c6c5 c6cc-c6cd c6d0 c6d4 c6dc-c6dd c6e0-c6e1 c6e8-c6e9 c6ec c6f0 c6f8-c6f9 c6fd c704-c705 c708 c70c c714-c715 c717 c719 c720-c721 c724 c728 c730-c731 c733 c735 c737 c73c-c73d c740 c744 c74a c74c-c74d c74f c751-c758 c75c c760 c768 c76b c774-c775 c778 c77c-c77e c783-c785 c787-c78a c78e c790-c791 c794 c796-c798 c79a c7a0-c7a1 c7a3-c7a6 c7ac-c7ad c7b0 c7b4 c7bc-c7bd c7bf-c7c1 c7c8-c7c9 c7cc c7ce c7d0 c7d8 c7dd c7e4 c7e8 c7ec c800-c801 c804 c808 c80a c810-c811 c813 c815-c816 c81c-c81d c820 c824 c82c-c82d c82f c831 c838 c83c c840 c848-c849 c84c-c84d c854 c870-c871 c874 c878 c87a c880-c881 c883 c885-c887 c88b-c88d c894 c89d c89f c8a1 c8a8 c8bc-c8bd c8c4 c8c8 c8cc c8d4-c8d5 c8d7 c8d9 c8e0-c8e1 c8e4 c8f5 c8fc-c8fd c900 c904-c906 c90c-c90d c90f c911 c918 c92c c934 c950-c951 c954 c958 c960-c961 c963 c96c c970 c974 c97c c988-c989 c98c c990 c998-c999 c99b c99d c9c0-c9c1 c9c4 c9c7-c9c8 c9ca c9d0-c9d1 c9d3 c9d5-c9d6 c9d9-c9da c9dc-c9dd c9e0 c9e2 c9e4 c9e7 c9ec-c9ed c9ef-c9f1 c9f8-c9f9 c9fc ca00 ca08-ca09 ca0b-ca0d ca14 ca18 ca29 ca4c-ca4d ca50 ca54 ca5c-ca5d ca5f-ca61 ca68 ca7d ca84 ca98 cabc-cabd cac0 cac4 cacc-cacd cacf cad1 cad3 cad8-cad9 cae0 caec caf4 cb08 cb10 cb14 cb18 cb20-cb21 cb41 cb48-cb49 cb4c cb50 cb58-cb59 cb5d cb64 cb78-cb79 cb9c cbb8 cbd4 cbe4 cbe7 cbe9 cc0c-cc0d cc10 cc14 cc1c-cc1d cc21-cc22 cc27-cc29 cc2c cc2e cc30 cc38-cc39 cc3b-cc3e cc44-cc45 cc48 cc4c cc54-cc55 cc57-cc59 cc60 cc64 cc66 cc68 cc70 cc75 cc98-cc99 cc9c cca0 cca8-cca9 ccab-ccad ccb4-ccb5 ccb8 ccbc ccc4-ccc5 ccc7 ccc9 ccd0 ccd4 cce4 ccec ccf0 cd01 cd08-cd09 cd0c cd10 cd18-cd19 cd1b cd1d cd24 cd28 cd2c cd39 cd5c cd60 cd64 cd6c-cd6d cd6f cd71 cd78 cd88 cd94-cd95 cd98 cd9c cda4-cda5 cda7 cda9 cdb0 cdc4 cdcc cdd0 cde8 cdec cdf0 cdf8-cdf9 cdfb cdfd ce04 ce08 ce0c ce14 ce19 ce20-ce21 ce24 ce28 ce30-ce31 ce33 ce35 ce58-ce59 ce5c ce5f-ce61 ce68-ce69 ce6b ce6d ce74-ce75 ce78 ce7c ce84-ce85 ce87 ce89 ce90-ce91 ce94 ce98 cea0-cea1 cea3-cea5 ceac-cead cec1 cee4-cee5 cee8 ceeb-ceec cef4-cef5 cef7-cef9 cf00-cf01 cf04 cf08 cf10-cf11 cf13 cf15 cf1c cf20 cf24 cf2c-cf2d cf2f-cf31 cf38 cf54-cf55 cf58 cf5c cf64-cf65 cf67 cf69 cf70-cf71 cf74 cf78 cf80 cf85 cf8c cfa1 cfa8 cfb0 cfc4 cfe0-cfe1 cfe4 cfe8 cff0-cff1 cff3 cff5 cffc d000 d004 d011 d018 d02d d034-d035 d038 d03c d044-d045 d047 d049 d050 d054 d058 d060 d06c-d06d d070 d074 d07c-d07d d081 d0a4-d0a5 d0a8 d0ac d0b4-d0b5 d0b7 d0b9 d0c0-d0c1 d0c4 d0c8-d0c9 d0d0-d0d1 d0d3-d0d5 d0dc-d0dd d0e0 d0e4 d0ec-d0ed d0ef-d0f1 d0f8 d10d d130-d131 d134 d138 d13a d140-d141 d143-d145 d14c-d14d d150 d154 d15c-d15d d15f d161 d168 d16c d17c d184 d188 d1a0-d1a1 d1a4 d1a8 d1b0-d1b1 d1b3 d1b5 d1ba d1bc d1c0 d1d8 d1f4 d1f8 d207 d209 d210 d22c-d22d d230 d234 d23c-d23d d23f d241 d248 d25c d264 d280-d281 d284 d288 d290-d291 d295 d29c d2a0 d2a4 d2ac d2b1 d2b8-d2b9 d2bc d2bf-d2c0 d2c2 d2c8-d2c9 d2cb d2d4 d2d8 d2dc d2e4-d2e5 d2f0-d2f1 d2f4 d2f8 d300-d301 d303 d305 d30c-d30e d310 d314 d316 d31c-d31d d31f-d321 d325 d328-d329 d32c d330 d338-d339 d33b-d33d d344-d345 d37c-d37d d380 d384 d38c-d38d d38f-d391 d398-d399 d39c d3a0 d3a8-d3a9 d3ab d3ad d3b4 d3b8 d3bc d3c4-d3c5 d3c8-d3c9 d3d0 d3d8 d3e1 d3e3 d3ec-d3ed d3f0 d3f4 d3fc-d3fd d3ff d401 d408 d41d d440 d444 d45c d460 d464 d46d d46f d478-d479 d47c d47f-d480 d482 d488-d489 d48b d48d d494 d4a9 d4cc d4d0 d4d4 d4dc d4df d4e8 d4ec d4f0 d4f8 d4fb d4fd d504 d508 d50c d514-d515 d517 d53c-d53d d540 d544 d54c-d54d d54f d551 d558-d559 d55c d560 d565 d568-d569 d56b d56d d574-d575 d578 d57c d584-d585 d587-d589 d590 d5a5 d5c8-d5c9 d5cc d5d0 d5d2 d5d8-d5d9 d5db d5dd d5e4-d5e5 d5e8 d5ec d5f4-d5f5 d5f7 d5f9 d600-d601 d604 d608 d610-d611 d613-d615 d61c d620 d624 d62d d638-d639 d63c d640 d645 d648-d649 d64b d64d d651 d654-d655 d658 d65c d667 d669 d670-d671 d674 d683 d685 d68c-d68d d690 d694 d69d d69f d6a1 d6a8 d6ac d6b0 d6b9 d6bb d6c4-d6c5 d6c8 d6cc d6d1 d6d4 d6d7 d6d9 d6e0 d6e4 d6e8 d6f0 d6f5 d6fc-d6fd d700 d704 d711 d718-d719 d71c d720 d728-d729 d72b d72d d734-d735 d738 d73c d744 d747 d749 d750-d751 d754 d756-d759 d760-d761 d763 d765 d769 d76c d770 d774 d77c-d77d d781 d788-d789 d78c d790 d798-d799 d79b d79d",
+	"kok":      "905-939 93f-94d",
+	"kr":       "41-5a 61-7a 18e 1dd 24c-24d",
+	"ks":       "620-624 626-628 63a 641-642 644-646 648 657 65f 672-673 679 67e 686 688 691 698 6a9 6af 6ba 6be 6c3-6c4 6cc 6d2",
+	"ku-am":    "410-425 427-42a 42d 430-445 447-44a 44d 4ba-4bb 4d8-4d9 4e6-4e7 51a-51d",
+	"ku-iq":    "626-628 62a 62c-62f 631-634 639-63a 641-642 644-648 67e 686 692 698 6a4 6a9 6af 6b5 6c6 6cc 6ce",
+	"ku-ir":    "626-628 62a 62c-62f 631-634 639-63a 641-642 644-648 67e 686 692 698 6a4 6a9 6af 6b5 6c6 6cc 6ce",
+	"ku-tr":    "41-5a 61-7a c7 ca ce db e7 ea ee fb 15e-15f",
+	"kum":      "401 410-44f 451",
+	"kv":       "401 406 410-44f 451 456 4e6-4e7",
+	"kw":       "41-5a 61-7a 100-101 112-113 12a-12b 14c-14d 16a-16b 232-233",
+	"kwm":      "41-5a 61-7a",
+	"ky":       "401 410-44f 451 4a2-4a3 4ae-4af",
+	"la":       "41-5a 61-7a 100-101 112-113 12a-12d 14c-14f 16a-16d",
+	"lah":      "621-624 626-628 63a 641-642 644-646 648 679 67e 686 688 691 698 6a9 6af 6ba 6be 6c3 6cc 6d2",
+	"lb":       "41-5a 61-7a c2 c4 c8-cb ce d4 d6 db-dc df e2 e4 e8-eb ee f4 f6 fb-fc",
+	"lez":      "401 406 410-44f 451",
+	"lg":       "41-5a 61-7a 14a-14b",
+	"li":       "41-5a 61-7a c4 c8 cb d3 d6 e4 e8 eb f3 f6",
+	"ln":       "41-5a 61-7a c1-c2 c9-ca cd-ce d3-d4 da-db e1-e2 e9-ea ed-ee f3-f4 fa-fb 11a-11b 186 190 254 25b 301-302 30c",
+	"lo":       "e81-e82 e84 e87-e88 e8a e8d e94-e97 e99-e9f ea1-ea3 ea5 ea7 eaa-eab ead-eb9 ebb-ebd ec0-ec4 ec6 ec8-ecd edc-edd",
+	"lt":       "41-5a 61-7a 104-105 10c-10d 116-119 12e-12f 160-161 16a-16b 172-173 17d-17e",
+	"lv":       "41-5a 61-7a 100-101 10c-10d 112-113 122-123 12a-12b 136-137 13b-13c 145-146 14c-14d 156-157 160-161 16a-16b 17d-17e",
+	"mai":      "905-939 93f-94d",
+	"mg":       "41-5a 61-7a c1 d4 e1 f4",
+	"mh":       "41-5a 61-7a 100-101 13b-13c 145-146 14c-14d 16a-16b",
+	"mi":       "41-5a 61-7a 100-101 112-113 12a-12b 14c-14d 16a-16b 1e34-1e35",
+	"mk":       "400 403 405 408-40a 40c-40d 40f-418 41a-428 450 453 455 458-45a 45c-45d 45f",
+	"ml":       "d02-d03 d05-d0c d0e-d10 d12-d28 d2a-d39 d3e-d43 d46-d48 d4a-d4d d57 d60-d61",
+	"mn-cn":    "1820-1877 1880-18a9",
+	"mn-mn":    "401 410-44f 451 4ae-4af 4e8-4e9",
+	"mni":      "964 981-983 985-98c 98f-990 993-9a8 9aa-9b0 9b2 9b6-9b9 9bc-9c3 9c7-9c8 9cb-9ce 9dc-9dd 9df 9e6-9ef 9f1",
+	"mo":       "41-5a 61-7a c2 ce e2 ee 102-103 218-21b 401 410-44f 451",
+	"mr":       "905-939 93f-94d",
+	"ms":       "41-5a 61-7a",
+	"mt":       "41-5a 61-7a c0 c8 cc ce d2 d9 e0 e8 ec ee f2 f9 10a-10b 120-121 126-127 17b-17c",
+	"my":       "1000-1021 1023-1027 1029-102a 102c-1032",
+	"na":       "41-5a 61-7a c3 d1 d5 e3 f1 f5 168-169",
+	"nb":       "41-5a 61-7a c0 c5-c6 c9-ca d2-d4 d8 e0 e5-e6 e9-ea f2-f4 f8",
+	"nds":      "41-5a 61-7a c4 d6 dc df e4 f6 fc",
+	"ne":       "901-903 905-90b 90f-910 913-928 92a-930 932 935-939 93e-943 947-948 94b-94d 950 964-970",
+	"ng":       "41-5a 61-7a",
+	"nl":       "41-5a 61-7a c1-c2 c4 c8-cb cd cf d3-d4 d6 da-dc e1-e2 e4 e8-eb ed ef f3-f4 f6 fa-fc",
+	"nn":       "41-5a 61-7a c0 c4-c6 c9-ca d2-d4 d6 d8 dc e0 e4-e6 e9-ea f2-f4 f6 f8 fc",
+	"no":       "41-5a 61-7a c0 c5-c6 c9-ca d2-d4 d8 e0 e5-e6 e9-ea f2-f4 f8",
+	"nqo":      "7c0-7fa",
+	"nr":       "41-5a 61-7a",
+	"nso":      "41-5a 61-7a ca d4 ea f4 160-161",
+	"nv":       "41-5a 61-7a c1 c9 cd d3 e1 e9 ed f3 104-105 118-119 12e-12f 141-142 1ea-1eb 2bc 301",
+	"ny":       "41-5a 61-7a 174-175",
+	"oc":       "41-5a 61-7a c0-c1 c7-c9 cd d2-d3 da e0-e1 e7-e9 ed f2-f3 fa",
+	"om":       "41-5a 61-7a",
+	"or":       "b01-b03 b05-b0c b0f-b10 b13-b28 b2a-b30 b32-b33 b36-b39 b3c-b43 b47-b48 b4b-b4d b56-b57 b5c-b5d b5f-b61",
+	"os":       "401 410-44f 451",
+	"ota":      "621-622 626-63a 641-648 67e 686 698 6ad 6af 6cc",
+	"pa":       "a05-a0a a0f-a10 a13-a28 a2a-a30 a32-a33 a35-a36 a38-a39 a3c a3e-a42 a47-a48 a4b-a4d a59-a5c a70-a74",
+	"pa-pk":    "621-624 626-628 63a 641-642 644-646 648 679 67e 686 688 691 698 6a9 6af 6ba 6be 6c3 6cc 6d2",
+	"pap-an":   "41-5a 61-7a c1 c8-c9 cd d1-d3 d9-da dc e1 e8-e9 ed f1-f3 f9-fa fc",
+	"pap-aw":   "41-5a 61-7a d1 f1",
+	"pl":       "41-5a 61-7a d3 f3 104-107 118-119 141-144 15a-15b 179-17c",
+	"ps-af":    "621-624 626-63a 641-642 644-648 64a 67c 67e 681 685-686 689 693 696 698 69a 6a9 6ab 6bc 6cc-6cd 6d0",
+	"ps-pk":    "621-624 626-63a 641-642 644-648 64a 67c 67e 681 685-686 689 693 696 698 69a 6a9 6ab 6bc 6cd 6d0 6d2",
+	"pt":       "41-5a 61-7a c0-c3 c7-ca cd d2-d5 da dc e0-e3 e7-ea ed f2-f5 fa fc",
+	"qu":       "41-5a 61-7a d1 f1 2c8",
+	"quz":      "41-5a 61-7a d1 f1 2c8",
+	"rm":       "41-5a 61-7a c0 c8-c9 cc ce d2 d9 e0 e8-e9 ec ee f2 f9",
+	"rn":       "41-5a 61-7a",
+	"ro":       "41-5a 61-7a c2 ce e2 ee 102-103 218-21b",
+	"ru":       "401 410-44f 451",
+	"rw":       "41-5a 61-7a",
+	"sa":       "905-939 93f-94d",
+	"sah":      "401 410-44f 451 494-495 4a4-4a5 4ae-4af 4ba-4bb 4d8-4d9",
+	"sat":      "901-903 905-90a 90f-910 913-928 92a-930 932 935 938-939 93c-942 947-948 94b-94d 950 964-970",
+	"sc":       "41-5a 61-7a c0 c8 cc d2 d9 e0 e8 ec f2 f9",
+	"sco":      "41-5a 61-7a 1b7 21c-21d 292",
+	"sd":       "621-622 624 626-628 62a-63a 641-642 644-648 64a 67a-67b 67d-680 683-684 686-687 68a 68c-68d 68f 699 6a6 6a9-6aa 6af 6b1 6b3 6bb 6be",
+	"se":       "41-5a 61-7a c1 e1 10c-10d 110-111 14a-14b 160-161 166-167 17d-17e",
+	"sel":      "401 410-44f 451",
+	"sg":       "41-5a 61-7a c2 c4 ca-cb ce-cf d4 d6 db-dc e2 e4 ea-eb ee-ef f4 f6 fb-fc",
+	"sh":       "41-5a 61-7a 106-107 10c-10d 110-111 160-161 17d-17e 402 408-40b 40f-44f 452 458-45b 45f 492-493 498-499 4a0-4a3 4aa-4ab 4ae-4af 4ba-4bb 4d8-4d9 4e8-4e9",
+	"shs":      "37 41 43 45 47-49 4b-55 57-59 61 63 65 67-69 6b-75 77-79 c1 c9 cd e1 e9 ed 313",
+	"si":       "d82-d83 d85-d8d d91-d96 d9a-da5 da7-db1 db3-dbb dbd dc0-dc6 dca dcf-dd4 dd6 dd8-dde df2",
+	"sid":      "1200-1206 1208-1216 1218-1226 1228-1230 1238-1246 1248 124a-124d 1250-1256 1258 125a-125d 1260-126e 1270-1286 1288 128a-128d 1290-12ae 12b0 12b2-12b5 12c0 12c2-12c5 12c8-12ce 12d0-12d6 12d8-12ee 12f0-12f7 1300-130e 1310 1312-1315 1320-1346 1348-1356",
+	"sk":       "41-5a 61-7a c1 c4 c9 cd d3-d4 da dd e1 e4 e9 ed f3-f4 fa fd 10c-10f 139-13a 13d-13e 147-148 154-155 160-161 164-165 17d-17e",
+	"sl":       "41-5a 61-7a 106-107 10c-10d 110-111 160-161 17d-17e",
+	"sm":       "41-5a 61-7a 2bb",
+	"sma":      "41-5a 61-7a c4-c5 cf d6 e4-e5 ef f6",
+	"smj":      "41-5a 61-7a c1 c4-c5 d1 e1 e4-e5 f1",
+	"smn":      "41-5a 61-7a c1-c2 c4 e1-e2 e4 10c-10d 110-111 14a-14b 160-161 17d-17e",
+	"sms":      "41-5a 61-7a c2 c4-c5 d5 e2 e4-e5 f5 10c-10d 110-111 14a-14b 160-161 17d-17e 1b7 1e4-1e9 1ee-1ef 292",
+	"sn":       "41-5a 61-7a",
+	"so":       "41-5a 61-7a",
+	"sq":       "41-5a 61-7a c7 cb e7 eb",
+	"sr":       "402 408-40b 40f-418 41a-428 430-438 43a-448 452 458-45b 45f",
+	"ss":       "41-5a 61-7a",
+	"st":       "41-5a 61-7a",
+	"su":       "41-5a 61-7a c9 e9",
+	"sv":       "41-5a 61-7a c0-c1 c4-c5 c9 cb d6 dc e0-e1 e4-e5 e9 eb f6 fc",
+	"sw":       "41-5a 61-7a",
+	"syr":      "710-72c 730-73f",
+	"ta":       "b83 b85-b8a b8e-b90 b92-b95 b99-b9a b9c b9e-b9f ba3-ba4 ba8-baa bae-bb5 bb7-bb9 bbe-bc2 bc6-bc8 bca-bcd bd7",
+	"te":       "c01-c03 c05-c0c c0e-c10 c12-c28 c2a-c33 c35-c39 c3e-c44 c46-c48 c4a-c4d c55-c56 c60-c61",
+	"tg":       "401 410-44f 451 492-493 49a-49b 4b2-4b3 4b6-4b7 4e2-4e3 4ee-4ef",
+	"th":       "e01-e3a e3f-e4e",
+	"ti-er":    "1200-1206 1208-1216 1218-121f 1228-1230 1238-1246 1248 124a-124d 1250-1256 1258 125a-125d 1260-126e 1270-127f 1290-12ae 12b0 12b2-12b5 12c0 12c2-12c5 12c8-12ce 12d0-12d6 12d8-12ee 12f0-12f7 1300-130e 1310 1312-1315 1320-133f 1348-1356",
+	"ti-et":    "1200-1206 1208-1216 1218-1226 1228-1230 1238-1246 1248 124a-124d 1250-1256 1258 125a-125d 1260-126e 1270-1286 1288 128a-128d 1290-12ae 12b0 12b2-12b5 12c0 12c2-12c5 12c8-12ce 12d0-12d6 12d8-12ee 12f0-12f7 1300-130e 1310 1312-1315 1320-1346 1348-1356",
+	"tig":      "1200-1206 1208-1216 1218-121f 1228-1230 1238-1246 1248 124a-124d 1260-126e 1270-127f 1290-1297 12a0-12a6 12a8-12ae 12b0 12b2-12b5 12c8-12ce 12d0-12d6 12d8-12df 12e8-12ee 12f0-12f7 1300-130e 1310 1312-1315 1320-133f 1348-1356",
+	"tk":       "41-5a 61-7a c4 c7 d6 dc-dd e4 e7 f6 fc-fd 147-148 15e-15f 17d-17e",
+	"tl":       "41-5a 61-7a c0-c2 c8-ca cc-ce d1-d4 d9-db e0-e2 e8-ea ec-ee f1-f4 f9-fb",
+	"tn":       "41-5a 61-7a ca d4 ea f4 160-161",
+	"to":       "41-5a 61-7a 2bb",
+	"tr":       "41-5a 61-7a c2 c7 ce d6 db-dc e2 e7 ee f6 fb-fc 11e-11f 130-131 15e-15f",
+	"ts":       "41-5a 61-7a",
+	"tt":       "401 410-44f 451 496-497 4a2-4a3 4ae-4af 4ba-4bb 4d8-4d9",
+	"tw":       "41-5a 61-7a c3 d1 d5 e3 f1 f5 128-129 168-169 186 190 254 25b 303 306 329 1ebc-1ebd 1ef8-1ef9",
+	"ty":       "41-5a 61-7a cf ef 100-101 112-113 12a-12b 14c-14d 16a-16b 2bc",
+	"tyv":      "401 410-44f 451 4a2-4a3 4ae-4af",
+	"ug":       "626-628 62a 62c 62e-62f 631-634 63a 641-646 648-64a 67e 686 698 6ad 6af 6be 6c6-6c8 6cb 6d0 6d5",
+	"uk":       "404 406-407 410-44f 454 456-457 490-491",
+	"und-zmth": "20-21 23-26 28-5e 61-7e a1-a7 ac b0-b1 b5-b7 bf d7 f7 131 308 30a 30c 338 391-3a1 3a3-3a4 3a6-3a9 3b1-3c1 3c3-3c9 3d5-3d6 3f0-3f1 2016 2020-2022 2026 2044 2057 20e1 2102 210e-2113 2115 2118-211d 2124 2200-220d 220f-2219 221d-222a 2234-2240 228c-22a5 22c0-22c3 22c8 22cd-22cf 2308-230b 2322-2323 25a0-25a1 27e6-27e9 1d400-1d454 1d456-1d49c 1d49e-1d49f 1d4a2 1d4a5-1d4a6 1d4a9-1d4ac 1d53b-1d53e 1d540-1d544 1d546 1d54a-1d550 1d6a4-1d6a5",
+	"und-zsye": "231a-231b 23e9-23ec 23f0 23f3 25fd-25fe 2614-2615 2648-2653 267f 2693 26a1 26aa-26ab 26bd-26be 26c4-26c5 26ce 26d4 26ea 26f2-26f3 26f5 26fa 26fd 2705 270a-270b 2728 274c 274e 2753-2755 2757 2795-2797 27b0 27bf 2b1b-2b1c 2b50 2b55 1f004 1f0cf 1f18e 1f191-1f19a 1f1e6-1f1ff 1f201 1f21a 1f22f 1f232-1f236 1f238-1f23a 1f250-1f251 1f300-1f320 1f330-1f335 1f337-1f37c 1f380-1f393 1f3a0-1f3c4 1f3c6-1f3ca 1f3e0-1f3f0 1f440 1f442-1f4f7 1f4f9-1f4fc 1f500-1f53d 1f550-1f567 1f5fb-1f5ff 1f601-1f610 1f612-1f614 1f616 1f618 1f61a 1f61c-1f61e 1f620-1f625 1f628-1f62b 1f62d 1f630-1f633 1f635-1f640 1f645-1f64f",
+	"ur":       "621-624 626-628 63a 641-642 644-646 648 679 67e 686 688 691 698 6a9 6af 6ba 6be 6c3 6cc 6d2",
+	"uz":       "41-5a 61-7a",
+	"ve":       "41-5a 61-7a 1e12-1e13 1e3c-1e3d 1e44-1e45 1e4a-1e4b 1e70-1e71",
+	"vi":       "41-5a 61-7a c0-c3 c8-ca cc-cd d2-d5 d9-da dd e0-e3 e8-ea ec-ed f2-f5 f9-fa fd 102-103 110-111 128-129 168-169 1a0-1a1 1af-1b0 300-303 306 309 31b 323 1ea0-1ef9",
+	"vo":       "41-50 52-56 58-5a 61-70 72-76 78-7a c4 d6 dc e4 f6 fc",
+	"vot":      "41-5a 61-7a c4 d6 dc e4 f6 fc 160-161 17d-17e",
+	"wa":       "41-5a 61-7a c2 c5 c7-ca ce d4 db e2 e5 e7-ea ee f4 fb",
+	"wal":      "1200-1206 1208-1216 1218-1226 1228-1230 1238-1246 1248 124a-124d 1250-1256 1258 125a-125d 1260-126e 1270-1286 1288 128a-128d 1290-12ae 12b0 12b2-12b5 12c0 12c2-12c5 12c8-12ce 12d0-12d6 12d8-12ee 12f0-12f7 1300-130e 1310 1312-1315 1320-1346 1348-1356",
+	"wen":      "41-5a 61-7a d3 f3 106-107 10c-10d 11a-11b 141-144 154-155 158-15b 160-161 179-17a 17d-17e",
+	"wo":       "41-5a 61-7a c0 c3 c9 cb d1 d3 e0 e3 e9 eb f1 f3 14a-14b",
+	"xh":       "41-5a 61-7a",
+	"yap":      "41-5a 61-7a c4 cb d6 e4 eb f6",
+	"yi":       "5d0-5ea",
+	"yo":       "41-5a 61-7a c0-c3 c8-ca cc-ce d2-d5 d9-db e0-e3 e8-ea ec-ee f2-f5 f9-fb 11a-11b 128-129 143-144 168-169 1cd-1d4 1f8-1f9 300-303 30c 1e3e-1e3f 1e62-1e63 1eb8-1eb9 1ebc-1ebd 1ecc-1ecd",
+	"za":       "41-5a 61-7a",
+	"zh-cn":    "2c7 2c9 4e00-4e01 4e03 4e07-4e0e 4e10-4e11 4e13-4e16 4e18-4e1e 4e22 4e24-4e25 4e27-4e28 4e2a-4e2d 4e30 4e32 4e34 4e36 4e38-4e3b 4e3d-4e3f 4e43 4e45 4e47-4e49 4e4b-4e50 4e52-4e54 4e56 4e58-4e59 4e5c-4e61 4e66 4e69 4e70-4e71 4e73 4e7e 4e86 4e88-4e89 4e8b-4e8f 4e91-4e95 4e98 4e9a-4e9b 4e9f-4ea2 4ea4-4ea9 4eab-4eae 4eb2-4eb3 4eb5 4eba-4ebb 4ebf-4ec7 4ec9-4ecb 4ecd-4ece 4ed1 4ed3-4ed9 4edd-4edf 4ee1 4ee3-4ee5 4ee8 4eea-4eec 4ef0 4ef2-4ef3 4ef5-4ef7 4efb 4efd 4eff 4f01 4f09-4f0a 4f0d-4f11 4f17-4f1b 4f1e-4f20 4f22 4f24-4f27 4f2a-4f2b 4f2f-4f30 4f32 4f34 4f36 4f38 4f3a 4f3c-4f3d 4f43 4f46 4f4d-4f51 4f53 4f55 4f57-4f60 4f63-4f65 4f67 4f69 4f6c 4f6f-4f70 4f73-4f74 4f76 4f7b-4f7c 4f7e-4f7f 4f83-4f84 4f88-4f89 4f8b 4f8d 4f8f 4f91 4f94 4f97 4f9b 4f9d 4fa0 4fa3 4fa5-4faa 4fac 4fae-4faf 4fb5 4fbf 4fc3-4fc5 4fca 4fce-4fd1 4fd7-4fd8 4fda 4fdc-4fdf 4fe1 4fe3 4fe6 4fe8-4fea 4fed-4fef 4ff1 4ff3 4ff8 4ffa 4ffe 500c-500d 500f 5012 5014 5018-501a 501c 501f 5021 5025-5026 5028-502a 502c-502e 503a 503c 503e 5043 5047-5048 504c 504e-504f 5055 505a 505c 5065 506c 5076-5077 507b 507e-5080 5085 5088 508d 50a3 50a5 50a7-50a9 50ac 50b2 50ba-50bb 50cf 50d6 50da 50e6-50e7 50ec-50ee 50f3 50f5 50fb 5106-5107 510b 5112 5121 513f-5141 5143-5146 5148-5149 514b 514d 5151 5154-5156 515a 515c 5162 5165 5168 516b-516e 5170-5171 5173-5179 517b-517d 5180-5182 5185 5188-5189 518c-518d 5192 5195-5197 5199 519b-519c 51a0 51a2 51a4-51a5 51ab-51ac 51af-51b3 51b5-51b7 51bb-51bd 51c0 51c4 51c6-51c7 51c9 51cb-51cc 51cf 51d1 51db 51dd 51e0-51e1 51e4 51eb 51ed 51ef-51f0 51f3 51f5-51f6 51f8-51fd 51ff-5203 5206-5208 520a 520d-520e 5211-5212 5216-521b 521d 5220 5224 5228-5229 522b 522d-522e 5230 5233 5236-523b 523d 523f-5243 524a 524c-524d 5250-5251 5254 5256 525c 525e 5261 5265 5267 5269-526a 526f 5272 527d 527f 5281-5282 5288 5290 5293 529b 529d-52a3 52a8-52ad 52b1-52b3 52be-52bf 52c3 52c7 52c9 52cb 52d0 52d2 52d6 52d8 52df 52e4 52f0 52f9-52fa 52fe-5300 5305-5306 5308 530d 530f-5310 5315-5317 5319-531a 531d 5320-5321 5323 5326 532a 532e 5339-533b 533e-533f 5341 5343 5345 5347-534a 534e-534f 5351-5353 5355-5357 535a 535c 535e-5364 5366-5367 5369 536b 536e-5371 5373-5375 5377-5378 537a 537f 5382 5384-5386 5389 538b-538d 5395 5398 539a 539d 539f 53a2-53a3 53a5-53a6 53a8-53a9 53ae 53b6 53bb 53bf 53c1-53c2 53c8-53cd 53d1 53d4 53d6-53d9 53db 53df-53e0 53e3-53e6 53e8-53f3 53f5-53f9 53fb-53fd 5401 5403-5404 5406 5408-540a 540c-5413 5415-5417 541b 541d-5421 5423 5426-5429 542b-542f 5431-5432 5434-5435 5438-5439 543b-543c 543e 5440 5443 5446 5448 544a-544b 5450 5452-5459 545b-545c 5462 5464 5466 5468 5471-5473 5475-5478 547b-547d 5480 5482 5484 5486 548b-548c 548e-5490 5492 5494-5496 5499-549b 549d 54a3-54a4 54a6-54ad 54af 54b1 54b3-54b4 54b8 54bb 54bd 54bf-54c2 54c4 54c6-54c9 54cc-54d5 54d7 54d9-54da 54dc-54df 54e5-54ea 54ed-54ee 54f2-54f3 54fa 54fc-54fd 54ff 5501 5506-5507 5509 550f-5511 5514 551b 5520 5522-5524 5527 552a 552c 552e-5531 5533 5537 553c 553e-553f 5541 5543-5544 5546 5549-554a 5550 5555-5556 555c 5561 5564-5567 556a 556c-556e 5575-5578 557b-557c 557e 5580-5584 5587-558b 558f 5591 5594 5598-5599 559c-559d 559f 55a7 55b1 55b3 55b5 55b7 55b9 55bb 55bd-55be 55c4-55c5 55c9 55cc-55cd 55d1-55d4 55d6 55dc-55dd 55df 55e1 55e3-55e6 55e8 55ea-55ec 55ef 55f2-55f3 55f5 55f7 55fd-55fe 5600-5601 5608-5609 560c 560e-560f 5618 561b 561e-561f 5623-5624 5627 562c-562d 5631-5632 5634 5636 5639 563b 563f 564c-564e 5654 5657-5659 565c 5662 5664 5668-566c 5671 5676 567b-567c 5685-5686 568e-568f 5693 56a3 56af 56b7 56bc 56ca 56d4 56d7 56da-56db 56dd-56e2 56e4 56eb 56ed 56f0-56f1 56f4-56f5 56f9-56fa 56fd-56ff 5703-5704 5706 5708-570a 571c 571f 5723 5728-572a 572c-5730 5733 5739-573b 573e 5740 5742 5747 574a 574c-5751 5757 575a-5761 5764 5766 5768-576b 576d 576f 5773 5776-5777 577b-577c 5782-5786 578b-578c 5792-5793 579b 57a0-57a4 57a6-57a7 57a9 57ab 57ad-57ae 57b2 57b4 57b8 57c2-57c3 57cb 57ce-57cf 57d2 57d4-57d5 57d8-57da 57dd 57df-57e0 57e4 57ed 57ef 57f4 57f8-57fa 57fd 5800 5802 5806-5807 580b 580d 5811 5815 5819 581e 5820-5821 5824 582a 5830 5835 5844 584c-584d 5851 5854 5858 585e 5865 586b-586c 587e 5880-5881 5883 5885 5889 5892-5893 5899-589a 589e-589f 58a8-58a9 58bc 58c1 58c5 58d1 58d5 58e4 58eb-58ec 58ee 58f0 58f3 58f6 58f9 5902 5904 5907 590d 590f 5914-5916 5919-591a 591c 591f 5924-5925 5927 5929-592b 592d-592f 5931 5934 5937-593a 593c 5941-5942 5944 5947-5949 594b 594e-594f 5951 5954-5958 595a 5960 5962 5965 5973-5974 5976 5978-5979 597d 5981-5984 5986-5988 598a 598d 5992-5993 5996-5997 5999 599e 59a3-59a5 59a8-59ab 59ae-59af 59b2 59b9 59bb 59be 59c6 59ca-59cb 59d0-59d4 59d7-59d8 59da 59dc-59dd 59e3 59e5 59e8 59ec 59f9 59fb 59ff 5a01 5a03-5a09 5a0c 5a11 5a13 5a18 5a1c 5a1f-5a20 5a23 5a25 5a29 5a31-5a32 5a34 5a36 5a3c 5a40 5a46 5a49-5a4a 5a55 5a5a 5a62 5a67 5a6a 5a74-5a77 5a7a 5a7f 5a92 5a9a-5a9b 5aaa 5ab2-5ab3 5ab5 5ab8 5abe 5ac1-5ac2 5ac9 5acc 5ad2 5ad4 5ad6 5ad8 5adc 5ae0-5ae1 5ae3 5ae6 5ae9 5aeb 5af1 5b09 5b16-5b17 5b32 5b34 5b37 5b40 5b50-5b51 5b53-5b55 5b57-5b5d 5b5f 5b62-5b66 5b69-5b6a 5b6c 5b70-5b71 5b73 5b75 5b7a 5b7d 5b80-5b81 5b83-5b85 5b87-5b89 5b8b-5b8c 5b8f 5b93 5b95 5b97-5b9e 5ba0-5ba6 5baa-5bab 5bb0 5bb3-5bb6 5bb8-5bb9 5bbd-5bbf 5bc2 5bc4-5bc7 5bcc 5bd0 5bd2-5bd3 5bdd-5bdf 5be1 5be4-5be5 5be8 5bee 5bf0 5bf8-5bfc 5bff 5c01 5c04 5c06 5c09-5c0a 5c0f 5c11 5c14-5c16 5c18 5c1a 5c1c-5c1d 5c22 5c24-5c25 5c27 5c2c 5c31 5c34 5c38-5c42 5c45 5c48-5c4b 5c4e-5c51 5c55 5c59 5c5e 5c60-5c61 5c63 5c65-5c66 5c6e-5c6f 5c71 5c79-5c7a 5c7f 5c81-5c82 5c88 5c8c-5c8d 5c90-5c91 5c94 5c96-5c9c 5ca2-5ca3 5ca9 5cab-5cad 5cb1 5cb3 5cb5 5cb7-5cb8 5cbd 5cbf 5cc1 5cc4 5ccb 5cd2 5cd9 5ce1 5ce4-5ce6 5ce8 5cea 5ced 5cf0 5cfb 5d02-5d03 5d06-5d07 5d0e 5d14 5d16 5d1b 5d1e 5d24 5d26-5d27 5d29 5d2d-5d2e 5d34 5d3d-5d3e 5d47 5d4a-5d4c 5d58 5d5b 5d5d 5d69 5d6b-5d6c 5d6f 5d74 5d82 5d99 5d9d 5db7 5dc5 5dcd 5ddb 5ddd-5dde 5de1-5de2 5de5-5de9 5deb 5dee-5def 5df1-5df4 5df7 5dfd-5dfe 5e01-5e03 5e05-5e06 5e08 5e0c 5e0f-5e11 5e14-5e16 5e18-5e1d 5e26-5e27 5e2d-5e2e 5e31 5e37-5e38 5e3b-5e3d 5e42 5e44-5e45 5e4c 5e54-5e55 5e5b 5e5e 5e61-5e62 5e72-5e74 5e76 5e78 5e7a-5e7d 5e7f-5e80 5e84 5e86-5e87 5e8a-5e8b 5e8f-5e91 5e93-5e97 5e99-5e9a 5e9c 5e9e-5ea0 5ea5-5ea7 5ead 5eb3 5eb5-5eb9 5ebe 5ec9-5eca 5ed1-5ed3 5ed6 5edb 5ee8 5eea 5ef4 5ef6-5ef7 5efa 5efe-5f04 5f08 5f0a-5f0b 5f0f 5f11 5f13 5f15 5f17-5f18 5f1b 5f1f-5f20 5f25-5f27 5f29-5f2a 5f2d 5f2f 5f31 5f39-5f3a 5f3c 5f40 5f50 5f52-5f53 5f55-5f58 5f5d 5f61-5f62 5f64 5f66 5f69-5f6a 5f6c-5f6d 5f70-5f71 5f73 5f77 5f79 5f7b-5f7c 5f80-5f82 5f84-5f85 5f87-5f8c 5f90 5f92 5f95 5f97-5f99 5f9c 5fa1 5fa8 5faa 5fad-5fae 5fb5 5fb7 5fbc-5fbd 5fc3-5fc6 5fc9 5fcc-5fcd 5fcf-5fd2 5fd6-5fd9 5fdd 5fe0-5fe1 5fe4 5fe7 5fea-5feb 5fed-5fee 5ff1 5ff5 5ff8 5ffb 5ffd-6006 600a 600d-600f 6012 6014-6016 6019 601b-601d 6020-6021 6025-602b 602f 6035 603b-603c 603f 6041-6043 604b 604d 6050 6052 6055 6059-605a 605d 6062-6064 6067-606d 606f-6070 6073 6076 6078-607d 607f 6083-6084 6089 608c-608d 6092 6094 6096 609a-609b 609d 609f-60a0 60a3 60a6 60a8 60ab-60ad 60af 60b1-60b2 60b4 60b8 60bb-60bc 60c5-60c6 60ca-60cb 60d1 60d5 60d8 60da 60dc-60dd 60df-60e0 60e6-60e9 60eb-60f0 60f3-60f4 60f6 60f9-60fa 6100-6101 6106 6108-6109 610d-610f 6115 611a 611f-6120 6123-6124 6126-6127 612b 613f 6148 614a 614c 614e 6151 6155 615d 6162 6167-6168 6170 6175 6177 618b 618e 6194 619d 61a7-61a9 61ac 61b7 61be 61c2 61c8 61ca-61cb 61d1-61d2 61d4 61e6 61f5 61ff 6206 6208 620a-6212 6215-6218 621a-621b 621f 6221-6222 6224-6225 622a 622c 622e 6233-6234 6237 623d-6241 6243 6247-6249 624b-624e 6251-6254 6258 625b 6263 6266-6267 6269-6270 6273 6276 6279 627c 627e-6280 6284 6289-628a 6291-6293 6295-6298 629a-629b 629f-62a2 62a4-62a5 62a8 62ab-62ac 62b1 62b5 62b9 62bb-62bd 62bf 62c2 62c4-62ca 62cc-62ce 62d0 62d2-62d4 62d6-62dc 62df 62e2-62e3 62e5-62e9 62ec-62ef 62f1 62f3-62f4 62f6-62f7 62fc-62ff 6301-6302 6307-6309 630e 6311 6316 631a-631b 631d-6325 6328 632a-632b 632f 6332 6339-633a 633d 6342-6343 6345-6346 6349 634b-6350 6355 635e-635f 6361-6363 6367 6369 636d-636e 6371 6376-6377 637a-637b 6380 6382 6387-638a 638c 638e-6390 6392 6396 6398 63a0 63a2-63a3 63a5 63a7-63aa 63ac-63ae 63b0 63b3-63b4 63b7-63b8 63ba 63bc 63be 63c4 63c6 63c9 63cd-63d0 63d2 63d6 63de 63e0-63e1 63e3 63e9-63ea 63ed 63f2 63f4 63f6 63f8 63fd 63ff-6402 6405 640b-640c 640f-6410 6413-6414 641b-641c 641e 6420-6421 6426 642a 642c-642d 6434 643a 643d 643f 6441 6444-6448 644a 6452 6454 6458 645e 6467 6469 646d 6478-647a 6482 6484-6485 6487 6491-6492 6495-6496 6499 649e 64a4 64a9 64ac-64ae 64b0 64b5 64b7-64b8 64ba 64bc 64c0 64c2 64c5 64cd-64ce 64d0 64d2 64d7-64d8 64de 64e2 64e4 64e6 6500 6509 6512 6518 6525 652b 652e-652f 6534-6536 6538-6539 653b 653e-653f 6545 6548-6549 654c 654f 6551 6555-6556 6559 655b 655d-655e 6562-6563 6566 656b-656c 6570 6572 6574 6577 6587 658b-658c 6590-6591 6593 6597 6599 659b-659c 659f 65a1 65a4-65a5 65a7 65a9 65ab 65ad 65af-65b0 65b9 65bc-65bd 65c1 65c3-65c6 65cb-65cc 65ce-65cf 65d2 65d6-65d7 65e0 65e2 65e5-65e9 65ec-65f1 65f6-65f7 65fa 6600 6602-6603 6606 660a 660c 660e-660f 6613-6615 6619 661d 661f-6620 6625 6627-6628 662d 662f 6631 6634-6636 663c 663e 6641 6643 664b-664c 664f 6652-6657 665a 665f 6661 6664 6666 6668 666e-6670 6674 6676-6677 667a 667e 6682 6684 6687 668c 6691 6696-6697 669d 66a7-66a8 66ae 66b4 66b9 66be 66d9 66db-66dd 66e6 66e9 66f0 66f2-66f4 66f7 66f9 66fc 66fe-6700 6708-670b 670d 6710 6714-6715 6717 671b 671d 671f 6726 6728 672a-672d 672f 6731 6734-6735 673a 673d 6740 6742-6743 6746 6748-6749 674c 674e-6751 6753 6756 675c 675e-6761 6765 6768-676a 676d 676f-6770 6772-6773 6775 6777 677c 677e-677f 6781 6784 6787 6789 678b 6790 6795 6797-6798 679a 679c-679e 67a2-67a3 67a5 67a7-67a8 67aa-67ab 67ad 67af-67b0 67b3 67b5-67b8 67c1 67c3-67c4 67cf-67d4 67d8-67da 67dc-67de 67e0 67e2 67e5 67e9 67ec 67ef-67f1 67f3-67f4 67fd 67ff-6800 6805 6807-680c 680e-680f 6811 6813 6816-6817 681d 6821 6829-682a 6832-6833 6837-6839 683c-683e 6840-6846 6848-684a 684c 684e 6850-6851 6853-6855 6860-6869 686b 6874 6876-6877 6881 6883 6885-6886 688f 6893 6897 68a2 68a6-68a8 68ad 68af-68b0 68b3 68b5 68c0 68c2 68c9 68cb 68cd 68d2 68d5 68d8 68da 68e0 68e3 68ee 68f0-68f1 68f5 68f9-68fa 68fc 6901 6905 690b 690d-690e 6910 6912 691f-6920 6924 692d 6930 6934 6939 693d 693f 6942 6954 6957 695a 695d-695e 6960 6963 6966 696b 696e 6971 6977-6979 697c 6980 6982 6984 6986-6989 698d 6994-6995 6998 699b-699c 69a7-69a8 69ab 69ad 69b1 69b4 69b7 69bb 69c1 69ca 69cc 69ce 69d0 69d4 69db 69df-69e0 69ed 69f2 69fd 69ff 6a0a 6a17-6a18 6a1f 6a21 6a28 6a2a 6a2f 6a31 6a35 6a3d-6a3e 6a44 6a47 6a50 6a58-6a59 6a5b 6a61 6a65 6a71 6a79 6a7c 6a80 6a84 6a8e 6a90-6a91 6a97 6aa0 6aa9 6aab-6aac 6b20-6b24 6b27 6b32 6b37 6b39-6b3a 6b3e 6b43 6b46-6b47 6b49 6b4c 6b59 6b62-6b67 6b6a 6b79 6b7b-6b7c 6b81-6b84 6b86-6b87 6b89-6b8b 6b8d 6b92-6b93 6b96 6b9a-6b9b 6ba1 6baa 6bb3-6bb5 6bb7 6bbf 6bc1-6bc2 6bc5 6bcb 6bcd 6bcf 6bd2-6bd7 6bd9 6bdb 6be1 6bea-6beb 6bef 6bf3 6bf5 6bf9 6bfd 6c05-6c07 6c0d 6c0f-6c11 6c13-6c16 6c18-6c1b 6c1f 6c21-6c22 6c24 6c26-6c2a 6c2e-6c30 6c32 6c34-6c35 6c38 6c3d 6c40-6c42 6c46-6c47 6c49-6c4a 6c50 6c54-6c55 6c57 6c5b-6c61 6c64 6c68-6c6a 6c70 6c72 6c74 6c76 6c79 6c7d-6c7e 6c81-6c83 6c85-6c86 6c88-6c89 6c8c 6c8f-6c90 6c93-6c94 6c99 6c9b 6c9f 6ca1 6ca3-6ca7 6ca9-6cab 6cad-6cae 6cb1-6cb3 6cb8-6cb9 6cbb-6cbf 6cc4-6cc5 6cc9-6cca 6ccc 6cd0 6cd3-6cd7 6cdb 6cde 6ce0-6ce3 6ce5 6ce8 6cea-6ceb 6cee-6cf1 6cf3 6cf5-6cf8 6cfa-6cfe 6d01 6d04 6d07 6d0b-6d0c 6d0e 6d12 6d17 6d19-6d1b 6d1e 6d25 6d27 6d2a-6d2b 6d2e 6d31-6d33 6d35 6d39 6d3b-6d3e 6d41 6d43 6d45-6d48 6d4a-6d4b 6d4d-6d4f 6d51-6d54 6d59-6d5a 6d5c 6d5e 6d60 6d63 6d66 6d69-6d6a 6d6e-6d6f 6d74 6d77-6d78 6d7c 6d82 6d85 6d88-6d89 6d8c 6d8e 6d91 6d93-6d95 6d9b 6d9d-6da1 6da3-6da4 6da6-6dab 6dae-6daf 6db2 6db5 6db8 6dbf-6dc0 6dc4-6dc7 6dcb-6dcc 6dd1 6dd6 6dd8-6dd9 6ddd-6dde 6de0-6de1 6de4 6de6 6deb-6dec 6dee 6df1 6df3 6df7 6df9 6dfb-6dfc 6e05 6e0a 6e0c-6e0e 6e10-6e11 6e14 6e16-6e17 6e1a 6e1d 6e20-6e21 6e23-6e25 6e29 6e2b 6e2d 6e2f 6e32 6e34 6e38 6e3a 6e43-6e44 6e4d-6e4e 6e53-6e54 6e56 6e58 6e5b 6e5f 6e6b 6e6e 6e7e-6e7f 6e83 6e85-6e86 6e89 6e8f-6e90 6e98 6e9c 6e9f 6ea2 6ea5 6ea7 6eaa 6eaf 6eb1-6eb2 6eb4 6eb6-6eb7 6eba-6ebb 6ebd 6ec1-6ec2 6ec7 6ecb 6ecf 6ed1 6ed3-6ed5 6ed7 6eda 6ede-6ee2 6ee4-6ee6 6ee8-6ee9 6ef4 6ef9 6f02 6f06 6f09 6f0f 6f13-6f15 6f20 6f24 6f29-6f2b 6f2d 6f2f 6f31 6f33 6f36 6f3e 6f46-6f47 6f4b 6f4d 6f58 6f5c 6f5e 6f62 6f66 6f6d-6f6e 6f72 6f74 6f78 6f7a 6f7c 6f84 6f88-6f89 6f8c-6f8e 6f9c 6fa1 6fa7 6fb3 6fb6 6fb9 6fc0 6fc2 6fc9 6fd1-6fd2 6fde 6fe0-6fe1 6fee-6fef 7011 701a-701b 7023 7035 7039 704c 704f 705e 706b-706d 706f-7070 7075-7076 7078 707c 707e-7080 7085 7089-708a 708e 7092 7094-7096 7099 709c-709d 70ab-70af 70b1 70b3 70b7-70b9 70bb-70bd 70c0-70c3 70c8 70ca 70d8-70d9 70db 70df 70e4 70e6-70e9 70eb-70ed 70ef 70f7 70f9 70fd 7109-710a 7110 7113 7115-7116 7118-711a 7126 712f-7131 7136 7145 714a 714c 714e 715c 715e 7164 7166-7168 716e 7172-7173 7178 717a 717d 7184 718a 718f 7194 7198-7199 719f-71a0 71a8 71ac 71b3 71b5 71b9 71c3 71ce 71d4-71d5 71e0 71e5 71e7 71ee 71f9 7206 721d 7228 722a 722c 7230-7231 7235-7239 723b 723d 723f 7247-7248 724c-724d 7252 7256 7259 725b 725d 725f 7261-7262 7266-7267 7269 726e-726f 7272 7275 7279-727a 727e-7281 7284 728a-728b 728d 728f 7292 729f 72ac-72ad 72af-72b0 72b4 72b6-72b9 72c1-72c4 72c8 72cd-72ce 72d0 72d2 72d7 72d9 72de 72e0-72e1 72e8-72e9 72ec-72f4 72f7-72f8 72fa-72fc 7301 7303 730a 730e 7313 7315-7317 731b-731e 7321-7322 7325 7329-732c 732e 7331 7334 7337-7339 733e-733f 734d 7350 7352 7357 7360 736c-736d 736f 737e 7384 7387 7389 738b 738e 7391 7396 739b 739f 73a2 73a9 73ab 73ae-73b0 73b2-73b3 73b7 73ba-73bb 73c0 73c2 73c8-73ca 73cd 73cf-73d1 73d9 73de 73e0 73e5 73e7 73e9 73ed 73f2 7403 7405-7406 7409-740a 740f-7410 741a-741b 7422 7425-7426 7428 742a 742c 742e 7430 7433-7436 743c 7441 7455 7457 7459-745c 745e-745f 746d 7470 7476-7477 747e 7480-7481 7483 7487 748b 748e 7490 749c 749e 74a7-74a9 74ba 74d2 74dc 74de 74e0 74e2-74e4 74e6 74ee-74ef 74f4 74f6-74f7 74ff 7504 750d 750f 7511 7513 7518-751a 751c 751f 7525 7528-7529 752b-752d 752f-7533 7535 7537-7538 753a-753b 753e 7540 7545 7548 754b-754c 754e-754f 7554 7559-755c 7565-7566 756a 7572 7574 7578-7579 757f 7583 7586 758b 758f 7591-7592 7594 7596-7597 7599-759a 759d 759f-75a1 75a3-75a5 75ab-75ac 75ae-75b5 75b8-75b9 75bc-75be 75c2-75c5 75c7-75ca 75cd 75d2 75d4-75d6 75d8 75db 75de 75e2-75e4 75e6-75e8 75ea-75eb 75f0-75f1 75f4 75f9 75fc 75ff-7601 7603 7605 760a 760c 7610 7615 7617-7619 761b 761f-7620 7622 7624-7626 7629-762b 762d 7630 7633-7635 7638 763c 763e-7640 7643 764c-764d 7654 7656 765c 765e 7663 766b 766f 7678 767b 767d-767e 7682 7684 7686-7688 768b 768e 7691 7693 7696 7699 76a4 76ae 76b1-76b2 76b4 76bf 76c2 76c5-76c6 76c8 76ca 76cd-76d2 76d4 76d6-76d8 76db 76df 76e5 76ee-76ef 76f1-76f2 76f4 76f8-76f9 76fc 76fe 7701 7704 7707-7709 770b 770d 7719-771a 771f-7720 7722 7726 7728-7729 772d 772f 7735-7738 773a 773c 7740-7741 7743 7747 7750-7751 775a-775b 7761-7763 7765-7766 7768 776b-776c 7779 777d-7780 7784-7785 778c-778e 7791-7792 779f-77a0 77a2 77a5 77a7 77a9-77aa 77ac 77b0 77b3 77b5 77bb 77bd 77bf 77cd 77d7 77db-77dc 77e2-77e3 77e5 77e7 77e9 77eb-77ee 77f3 77f6 77f8 77fd-7802 7809 780c-780d 7811-7812 7814 7816-7818 781a 781c-781d 781f 7823 7825-7827 7829 782c-782d 7830 7834 7837-783c 783e 7840 7845 7847 784c 784e 7850 7852 7855-7857 785d 786a-786e 7877 787c 7887 7889 788c-788e 7891 7893 7897-7898 789a-789c 789f 78a1 78a3 78a5 78a7 78b0-78b4 78b9 78be 78c1 78c5 78c9-78cb 78d0 78d4-78d5 78d9 78e8 78ec 78f2 78f4 78f7 78fa 7901 7905 7913 791e 7924 7934 793a-793c 793e 7940-7941 7946 7948-7949 7953 7956-7957 795a-7960 7962 7965 7967-7968 796d 796f 7977-7978 797a 7980-7981 7984-7985 798a 798f 799a 79a7 79b3 79b9-79bb 79bd-79be 79c0-79c1 79c3 79c6 79c9 79cb 79cd 79d1-79d2 79d5 79d8 79df 79e3-79e4 79e6-79e7 79e9 79eb 79ed 79ef-79f0 79f8 79fb 79fd 7a00 7a02-7a03 7a06 7a0b 7a0d-7a0e 7a14 7a17 7a1a 7a1e 7a20 7a23 7a33 7a37 7a39 7a3b-7a3d 7a3f 7a46 7a51 7a57 7a70 7a74 7a76-7a7a 7a7f-7a81 7a83-7a84 7a86 7a88 7a8d 7a91-7a92 7a95-7a98 7a9c-7a9d 7a9f-7aa0 7aa5-7aa6 7aa8 7aac-7aad 7ab3 7abf 7acb 7ad6 7ad9 7ade-7ae0 7ae3 7ae5-7ae6 7aed 7aef 7af9-7afa 7afd 7aff 7b03-7b04 7b06 7b08 7b0a-7b0b 7b0f 7b11 7b14-7b15 7b19 7b1b 7b1e 7b20 7b24-7b26 7b28 7b2a-7b2c 7b2e 7b31 7b33 7b38 7b3a 7b3c 7b3e 7b45 7b47 7b49 7b4b-7b4c 7b4f-7b52 7b54 7b56 7b58 7b5a-7b5b 7b5d 7b60 7b62 7b6e 7b71-7b72 7b75 7b77 7b79 7b7b 7b7e 7b80 7b85 7b8d 7b90 7b94-7b95 7b97 7b9c-7b9d 7ba1-7ba2 7ba6-7bad 7bb1 7bb4 7bb8 7bc1 7bc6-7bc7 7bcc 7bd1 7bd3 7bd9-7bda 7bdd 7be1 7be5-7be6 7bea 7bee 7bf1 7bf7 7bfc 7bfe 7c07 7c0b-7c0c 7c0f 7c16 7c1f 7c26-7c27 7c2a 7c38 7c3f-7c41 7c4d 7c73-7c74 7c7b-7c7d 7c89 7c91-7c92 7c95 7c97-7c98 7c9c-7c9f 7ca2 7ca4-7ca5 7caa 7cae 7cb1-7cb3 7cb9 7cbc-7cbe 7cc1 7cc5 7cc7-7cc8 7cca 7ccc-7ccd 7cd5-7cd7 7cd9 7cdc 7cdf-7ce0 7ce8 7cef 7cf8 7cfb 7d0a 7d20 7d22 7d27 7d2b 7d2f 7d6e 7d77 7da6 7dae 7e3b 7e41 7e47 7e82 7e9b 7e9f-7ead 7eaf-7eb3 7eb5-7eba 7ebd-7ed5 7ed7-7ee3 7ee5-7eeb 7eed-7ef8 7efa-7f09 7f0b-7f0f 7f11-7f1d 7f1f-7f36 7f38 7f3a 7f42 7f44-7f45 7f50-7f51 7f54-7f55 7f57-7f58 7f5a 7f5f 7f61-7f62 7f68-7f6a 7f6e 7f71-7f72 7f74 7f79 7f7e 7f81 7f8a 7f8c 7f8e 7f94 7f9a 7f9d-7f9f 7fa1 7fa4 7fa7 7faf-7fb0 7fb2 7fb8-7fb9 7fbc-7fbd 7fbf 7fc1 7fc5 7fca 7fcc 7fce 7fd4-7fd5 7fd8 7fdf-7fe1 7fe5-7fe6 7fe9 7fee 7ff0-7ff1 7ff3 7ffb-7ffc 8000-8001 8003-8006 800b-800d 8010 8012 8014-8019 801c 8020 8022 8025-802a 8031 8033 8035-8038 803b 803d 803f 8042-8043 8046 804a-804d 8052 8054 8058 805a 8069-806a 8071 807f-8080 8083-8084 8086-8087 8089 808b-808c 8093 8096 8098 809a-809d 809f-80a2 80a4-80a5 80a9-80ab 80ad-80af 80b1-80b2 80b4 80b7 80ba 80bc-80c4 80c6 80cc-80ce 80d6-80d7 80d9-80de 80e1 80e4-80e5 80e7-80ed 80ef-80f4 80f6 80f8 80fa 80fc-80fd 8102 8106 8109-810a 810d-8114 8116 8118 811a 811e 812c 812f 8131-8132 8136 8138 813e 8146 8148 814a-814c 8150-8151 8153-8155 8159-815a 8160 8165 8167 8169 816d-816e 8170-8171 8174 8179-8180 8182 8188 818a 818f 8191 8198 819b-819d 81a3 81a6 81a8 81aa 81b3 81ba-81bb 81c0-81c3 81c6 81ca 81cc 81e3 81e7 81ea 81ec-81ed 81f3-81f4 81fb-81fc 81fe 8200-8202 8204-8206 820c-820d 8210 8212 8214 821b-821c 821e-821f 8221-8223 8228 822a-822d 822f-8231 8233-8239 823b 823e 8244 8247 8249 824b 824f 8258 825a 825f 8268 826e-8270 8272-8274 8279-827a 827d-827f 8282 8284 8288 828a-828b 828d-828f 8291-8292 8297-8299 829c-829d 829f 82a1 82a4-82a6 82a8-82b1 82b3-82b4 82b7-82b9 82bd-82be 82c1 82c4 82c7-82c8 82ca-82cf 82d1-82d5 82d7-82d8 82db-82dc 82de-82e1 82e3-82e6 82eb 82ef 82f1 82f4 82f7 82f9 82fb 8301-8309 830c 830e-830f 8311 8314-8315 8317 831a-831c 8327-8328 832b-832d 832f 8331 8333-8336 8338-833a 833c 8340 8343 8346-8347 8349 834f-8352 8354 835a-835c 835e-8361 8363-836f 8377-8378 837b-837d 8385-8386 8389 838e 8392-8393 8398 839b-839c 839e 83a0 83a8-83ab 83b0-83b4 83b6-83ba 83bc-83bd 83c0-83c1 83c5 83c7 83ca 83cc 83cf 83d4 83d6 83d8 83dc-83dd 83df-83e1 83e5 83e9-83ea 83f0-83f2 83f8-83f9 83fd 8401 8403-8404 8406 840b-840f 8411 8418 841c-841d 8424-8428 8431 8438 843c-843d 8446 8451 8457 8459-845c 8461 8463 8469 846b-846d 8471 8473 8475-8476 8478 847a 8482 8487-8489 848b-848c 848e 8497 8499 849c 84a1 84af 84b2 84b4 84b8-84ba 84bd 84bf 84c1 84c4 84c9-84ca 84cd 84d0-84d1 84d3 84d6 84dd 84df-84e0 84e3 84e5-84e6 84ec 84f0 84fc 84ff 850c 8511 8513 8517 851a 851f 8521 852b-852c 8537-853d 8543 8548-854a 8556 8559 855e 8564 8568 8572 8574 8579-857b 857e 8584-8585 8587 858f 859b-859c 85a4 85a8 85aa 85ae-85b0 85b7 85b9 85c1 85c9 85cf-85d0 85d3 85d5 85dc 85e4 85e9 85fb 85ff 8605 8611 8616 8627 8629 8638 863c 864d-8651 8654 865a 865e 8662 866b-866c 866e 8671 8679-8682 868a-868d 8693 8695 869c-869d 86a3-86a4 86a7-86aa 86ac 86af-86b1 86b4-86b6 86ba 86c0 86c4 86c6-86c7 86c9-86cb 86ce-86d1 86d4 86d8-86d9 86db 86de-86df 86e4 86e9 86ed-86ee 86f0-86f4 86f8-86f9 86fe 8700 8702-8703 8707-870a 870d 8712-8713 8715 8717-8718 871a 871c 871e 8721-8723 8725 8729 872e 8731 8734 8737 873b 873e-873f 8747-8749 874c 874e 8753 8757 8759 8760 8763-8765 876e 8770 8774 8776 877b-877e 8782-8783 8785 8788 878b 878d 8793 8797 879f 87a8 87ab-87ad 87af 87b3 87b5 87ba 87bd 87c0 87c6 87ca-87cb 87d1-87d3 87db 87e0 87e5 87ea 87ee 87f9 87fe 8803 880a 8813 8815-8816 881b 8821-8822 8832 8839 883c 8840 8844-8845 884c-884d 8854 8857 8859 8861-8865 8868-8869 886b-886c 886e 8870 8872 8877 887d-887f 8881-8882 8884-8885 8888 888b 888d 8892 8896 889c 88a2 88a4 88ab 88ad 88b1 88b7 88bc 88c1-88c2 88c5-88c6 88c9 88ce 88d2 88d4-88d5 88d8-88d9 88df 88e2-88e5 88e8 88f0-88f1 88f3-88f4 88f8-88f9 88fc 88fe 8902 890a 8910 8912-8913 8919-891b 8921 8925 892a-892b 8930 8934 8936 8941 8944 895e-895f 8966 897b 897f 8981 8983 8986 89c1-89c2 89c4-89cc 89ce-89d2 89d6 89da 89dc 89de 89e3 89e5-89e6 89eb 89ef 89f3 8a00 8a07 8a3e 8a48 8a79 8a89-8a8a 8a93 8b07 8b26 8b66 8b6c 8ba0-8bab 8bad-8bb0 8bb2-8bba 8bbc-8bc6 8bc8-8bcf 8bd1-8be9 8beb-8c08 8c0a-8c1d 8c1f-8c37 8c41 8c46-8c47 8c49 8c4c 8c55 8c5a 8c61-8c62 8c6a-8c6b 8c73 8c78-8c7a 8c82 8c85 8c89-8c8a 8c8c 8c94 8c98 8d1d-8d1f 8d21-8d50 8d53-8d56 8d58-8d5e 8d60-8d64 8d66-8d67 8d6b 8d6d 8d70 8d73-8d77 8d81 8d84-8d85 8d8a-8d8b 8d91 8d94 8d9f 8da3 8db1 8db3-8db5 8db8 8dba 8dbc 8dbe-8dbf 8dc3-8dc4 8dc6 8dcb-8dcc 8dce-8dcf 8dd1 8dd6-8dd7 8dda-8ddb 8ddd-8ddf 8de3-8de4 8de8 8dea-8dec 8def 8df3 8df5 8df7-8dfb 8dfd 8e05 8e09-8e0a 8e0c 8e0f 8e14 8e1d-8e1f 8e22-8e23 8e29-8e2a 8e2c 8e2e-8e2f 8e31 8e35 8e39-8e3a 8e3d 8e40-8e42 8e44 8e47-8e4b 8e51-8e52 8e59 8e66 8e69 8e6c-8e6d 8e6f-8e70 8e72 8e74 8e76 8e7c 8e7f 8e81 8e85 8e87 8e8f-8e90 8e94 8e9c 8e9e 8eab-8eac 8eaf 8eb2 8eba 8ece 8f66-8f69 8f6b-8f7f 8f81-8f8b 8f8d-8f91 8f93-8f9c 8f9e-8f9f 8fa3 8fa8-8fa9 8fab 8fb0-8fb1 8fb6 8fb9 8fbd-8fbe 8fc1-8fc2 8fc4-8fc5 8fc7-8fc8 8fce 8fd0-8fd1 8fd3-8fd5 8fd8-8fd9 8fdb-8fdf 8fe2 8fe4-8fe6 8fe8-8feb 8fed-8fee 8ff0 8ff3 8ff7-8ff9 8ffd 9000-9006 9009-900b 900d 900f-9012 9014 9016-9017 901a-901b 901d-9022 9026 902d-902f 9035-9036 9038 903b-903c 903e 9041-9042 9044 9047 904d 904f-9053 9057-9058 905b 9062-9063 9065 9068 906d-906e 9074-9075 907d 907f-9080 9082-9083 9088 908b 9091 9093 9095 9097 9099 909b 909d 90a1-90a3 90a6 90aa 90ac 90ae-90b1 90b3-90b6 90b8-90bb 90be 90c1 90c4-90c5 90c7 90ca 90ce-90d1 90d3 90d7 90db-90dd 90e1-90e2 90e6-90e8 90eb 90ed 90ef 90f4 90f8 90fd-90fe 9102 9104 9119 911e 9122-9123 912f 9131 9139 9143 9146 9149-9150 9152 9157 915a 915d-915e 9161-9165 9169-916a 916c 916e-9172 9174-9179 917d-917f 9185 9187 9189 918b-918d 9190-9192 919a-919b 91a2-91a3 91aa 91ad-91af 91b4-91b5 91ba 91c7 91c9-91ca 91cc-91cf 91d1 91dc 9274 928e 92ae 92c8 933e 936a 938f 93ca 93d6 943e 946b 9485-9490 9492-9495 9497 9499-94c6 94c8-94ce 94d0-94d2 94d5-94d9 94db-94e5 94e7-94fa 94fc-951b 951d-951f 9521-9526 9528-9532 9534-953c 953e-9542 9544-9547 9549-954a 954c-9554 9556-9559 955b-955f 9561-956d 956f-9573 9576 957f 95e8-95eb 95ed-95fe 9600-9606 9608-9612 9614-9617 9619-961a 961c-961d 961f 9621-9622 962a 962e 9631-9636 963b-963d 963f-9640 9642 9644-9649 964b-964d 9650 9654-9655 965b 965f 9661-9662 9664 9667-966a 966c 9672 9674-9677 9685-9686 9688 968b 968d 968f-9690 9694 9697-9699 969c 96a7 96b0 96b3 96b6 96b9 96bc-96be 96c0-96c1 96c4-96c7 96c9 96cc-96cf 96d2 96d5 96e0 96e8-96ea 96ef 96f3 96f6-96f7 96f9 96fe 9700-9701 9704 9706-9709 970d-970f 9713 9716 971c 971e 972a 972d 9730 9732 9738-9739 973e 9752-9753 9756 9759 975b 975e 9760-9762 9765 9769 9773-9774 9776 977c 9785 978b 978d 9791-9792 9794 9798 97a0 97a3 97ab 97ad 97af 97b2 97b4 97e6-97e7 97e9-97ed 97f3 97f5-97f6 9875-988a 988c-988d 988f-9891 9893-9894 9896-9898 989a-98a2 98a4-98a7 98ce 98d1-98d3 98d5 98d8-98da 98de-98df 98e7-98e8 990d 9910 992e 9954-9955 9963 9965 9967-9972 9974-9977 997a 997c-997d 997f-9981 9984-9988 998a-998b 998d 998f-9999 99a5 99a8 9a6c-9a71 9a73-9a82 9a84-9a88 9a8a-9a8c 9a8f-9a93 9a96-9a98 9a9a-9aa5 9aa7-9aa8 9ab0-9ab1 9ab6-9ab8 9aba 9abc 9ac0-9ac2 9ac5 9acb-9acc 9ad1 9ad3 9ad8 9adf 9ae1 9ae6 9aeb 9aed 9aef 9af9 9afb 9b03 9b08 9b0f 9b13 9b1f 9b23 9b2f 9b32 9b3b-9b3c 9b41-9b45 9b47-9b49 9b4d 9b4f 9b51 9b54 9c7c 9c7f 9c81-9c82 9c85-9c88 9c8b 9c8d-9c8e 9c90-9c92 9c94-9c95 9c9a-9c9c 9c9e-9ca9 9cab 9cad-9cae 9cb0-9cb8 9cba-9cbd 9cc3-9cc7 9cca-9cd0 9cd3-9cd9 9cdc-9cdf 9ce2 9e1f-9e23 9e25-9e26 9e28-9e2d 9e2f 9e31-9e33 9e35-9e3a 9e3d-9e3f 9e41-9e4c 9e4e-9e4f 9e51 9e55 9e57-9e58 9e5a-9e5c 9e5e 9e63-9e64 9e66-9e6d 9e70-9e71 9e73 9e7e-9e7f 9e82 9e87-9e88 9e8b 9e92-9e93 9e9d 9e9f 9ea6 9eb4 9eb8 9ebb 9ebd-9ebe 9ec4 9ec9 9ecd-9ecf 9ed1 9ed4 9ed8 9edb-9edd 9edf-9ee0 9ee2 9ee5 9ee7 9ee9-9eea 9eef 9ef9 9efb-9efc 9efe 9f0b 9f0d-9f0e 9f10 9f13 9f17 9f19 9f20 9f22 9f2c 9f2f 9f37 9f39 9f3b 9f3d-9f3e 9f44 9f50-9f51 9f7f-9f80 9f83-9f8c 9f99-9f9b 9f9f-9fa0",
+	"zh-hk":    "3007 344c 3464 3473 347a 347d-347e 3493 3496 34a5 34bc 34c1 34c8 34df 34e4 34fb 3506 353e 3551 3561 356d 3570 3572 3577-3578 3584 3597 35a1 35a5 35ad 35bf 35c1 35c5 35c7 35ca 35ce 35d2 35d6 35db 35f1-35f3 35fb 35fe 3609 361a 3623 362d 3635 3639 3647-3649 364e 365f 367a 3681 36a5 36aa 36ac 36b0-36b1 36b5 36b9 36bc 36c1 36c3-36c5 36d3-36d4 36d6 36dd 36e5-36e6 36f5 3703 3708 370a 370d 371c 3723 3725 3730 3732-3733 373a 3740 3743 3762 376f 3797 37a0 37b9 37be 37f2 37f8 37fb 380f 3819 3820 382d 3836 3838 3863 38a0 38c3 38cc 38d1 38fa 3908 3914 3927 3932 393f 394d 3963 3980 3989-398a 3992 399b 39a1 39a4 39b8 39dc 39e2 39e5 39ec 39f8 39fb 39fe 3a01 3a03 3a06 3a17-3a18 3a29-3a2a 3a34 3a4b 3a52 3a57 3a5c 3a5e 3a66-3a67 3a97 3aab 3abd 3ade 3af0 3af2 3afb 3b0e 3b19 3b22 3b2b 3b39 3b42 3b58 3b60 3b71-3b72 3b7b-3b7c 3b80 3b96 3b99 3ba1 3bbe 3bc2 3bc4 3bd7 3bdd 3bec 3bf2-3bf3 3c0d 3c11 3c15 3c54 3ccb 3ccd 3cd1 3cd6 3cdc 3ceb 3d13 3d1d 3d32 3d46 3d4c 3d4e 3d51 3d5f 3d62 3d69-3d6a 3d6f 3d75 3d7d 3d85 3d8f 3d91 3da5 3dad 3db4 3dbf 3dc6-3dc7 3dcd 3dd3 3ddb 3deb 3df3 3df7 3dfc 3e40 3e43 3e48 3e55 3e74 3ea8-3eaa 3ead 3eb1 3eb8 3ebf 3ec2 3eca 3ecc 3ed1 3ed6-3ed7 3ede 3ee1 3ee7 3eeb 3ef0 3efa 3eff 3f04 3f0e 3f58-3f59 3f63 3f93 3fc0 3fd7 3fdc 3fe5 3fed 3ff9-3ffa 4004 4039 4045 4053 4057 4062 4065 406a 406f 40bb 40bf 40c8 40d8 40df 40fa 4103-4104 4109 410e 4132 4167 416c 416e 417f 4190 41b2 41cf 41db 41ef 41f9 4211 4240 4260 426a 427a 4294 42a2 42b5 42b9 42bc 42f4 42fb-42fc 432b 436e 4397 43ba 43c1 43d9 43df 43ed 43f2 4401-4402 4413 447a 448f 449f-44a0 44b0 44b7 44dd 44df 44e4 44ea 44f4 4503-4504 4509 4516 4527 452e 4533 453b 453f 4543 4551-4552 4555 4562 456a 4577 4585 45e9 4603 4606 460f 4615 4617 465b 467a 46cf-46d0 46f5 4718 477c 47d5 47ed 47f4 4800 480b 4871 489b 48ad 48d0 48dd 48ed 48fa 4906 491e 492a 492d 4935 493c 493e 4945 4951 4953 4965 496a 4972 4989 49a7 49df 49e5 4a0f 4a1d 4a24 4a35 4a96 4ab4 4ab8 4ad1 4ae4 4aff 4b19 4b2c 4b37 4b6f-4b70 4b72 4b7b 4b7e 4b8e 4b90 4b93 4b96-4b97 4b9d 4bbd-4bbe 4bc0 4c04 4c07 4c0e 4c3b 4c3e 4c5b 4c6d 4c77 4c7b 4c7d 4c81 4cae 4cb0 4ccd 4ce1 4ced 4d09 4d10 4d34 4d77 4d91 4d9c 4e04 4e21 4e2a 4e5a-4e5b 4e6a 4e78 4e80 4e85 4e98 4ece 4eee 4f37 4fe5 4ff9 5008 503b 50cd 510d-510e 516a 5186 519a 51a7-51a8 51b2-51b5 51c9 51ed 51f4 520b 5226-5227 5234 523c 5257 528f 52b5 52b9 52c5 52d1 5338 5374 537d 5393 53a0 53a6 53a8 53c1 53cc 53d9 53e0 53f6 53fe 5413-5414 5416 5421 544c-544d 546a 546d 548f 5493-5494 5497 54a4 54b2 54cb 54cd 54e3 5502 5513 551e 5525 5553 555d 5569 556b 5571-5572 5579 5586 5590 55a9 55b0 55ba 55bc 55d7 55de 55ec 55f0-55f1 55fb 5605 5611 561e 5622-5623 5625 562d 5643 564d 564f 5652 5654 565d 5689 5692 569f 56a1 56a4 56b1 56b9 56bf 56d6 56fd 5742 577a 57c8 57d7 57de 5803 5826 583a 5840 5869 5872-5873 58aa 58bb 58e0 58f2-58f3 58fb 590a 5975 599f 59ac 59c9 59eb 59f8 5a2b 5a7e 5af2 5afa 5b46 5b6d 5b9d 5b9f 5bc3 5bdb 5bf3 5c05 5c4a 5c5e 5cef 5d8b 5df5 5e7a 5e83 5ed0 5ef8-5ef9 5efb-5efc 5f0c-5f0e 5f5c 5fa7 5fdf 6031 6075 609e 60a4 60d7 60e3 6159 6164 617d 6187 61d0 6239 629d 62a6 62c3 62c5 62d5 6331 6379 63b9 63d1 63de 63e6 63f8 63fc 63fe 6407 6432 643a 647c 648d 6491 64b4 64dd 64e1 64e7 651e 6530 654d 6586 6589 65e3 6630 6644 664b 6667 666b 6673 668e 66f1 6725 6736 6761 6767 67a0 67b1 6803-6804 681e 6822 6898 68b6 6900 6936 6961 6973 698a 69b2 6a0b 6a2b 6ac8 6b35 6b6f 6b74 6b7a 6be1 6c37 6c39 6c5a 6ca2 6cea 6d5c 6d72 6d96 6e15 6e29 6e7c 6ed9 6edb 6edd 6f16 6f56 6f81 6fbe 6ff6 701e 702c 7081 7089 70b9 70df 70f1 7105 712b 7140 7145 714a 7151 7171 71f6 7215 7240 7282 7287 732a 732e 7341 7374 73c9 73cf 7439 743c 7448 7460 7505 7534 753b 754a 7551 7553 7560 7567 758d-758e 75b1 75b4 7602 763b 764e 7666-7667 7676 767a 770c 771e 7740 7758 7778 777a 7793 77b9 77cb 7808 7881 788d 78b1 78b8 78d7 7906 792e 7958 7962 7991 79c4 7a93 7ab0 7ac8-7ac9 7adc-7add 7aea 7b0b 7b39 7b6f 7c15 7ca6-7ca7 7cae 7cc9 7ccd 7ced 7cf9 7cfc 7d25 7d5d 7d89 7dab 7db3 7dcd 7dcf 7ddc 7e6e 7f47 7f49 7f4e 7f78 7f97 7fa3 8061 80b6 80bd 80c6 8107 8117 8137 81a5 81b6 81ef 8218 8226 8276 82a6 82aa 82f7 8318 83d3 8418 8420 8471 84ad 84bd 84e2 8503 8534 8570 8602 862f 86ef 8786 87ce 8804 882d 8846 885e 889c 88c7 88cf 8947 8987 8994 89a5 89a7 8a94 8b4c 8b81 8b83 8b90 8ccd 8cdb 8d03 8d0b 8e0e 8e2a 8e2d 8e4f 8e7e 8e80 8ead 8eda 8ee2 8ef2 8f2d 8fb5 8fba-8fbc 8ff9 9033 9056 9061 90a8 9176 9208 920e 922a 9244 9255 925d 9262 926e 92b9 92be 9307 9340 9345 9348 9369 9384-9385 9387 93ad 93bf 93f0 9404 9426-9427 9454 945b 9465 9599 95a2 95aa 9696 96a3 9721 9751 976d 97ee 97f5 9834 98b7 98c8 98e0 991c 9938 994a 994d 9962 99c5 99e1 9a10 9b2a 9b2d 9b81 9b8b 9b8e 9bed 9bf1 9bff 9c02 9c0c 9c2f 9c35 9c3a 9c45 9c5d 9c72 9d34 9d50 9d5e 9d93 9dc0 9dc4 9dc9 9dd4 9e0a 9e0c 9e90 9e95-9e96 9eaa-9eab 9eaf 9ebf 9f08 9f26 9f62 9f8e 200ca 201a4 201a9 20325 20341 2070e 20779 20c41 20c53 20c65 20c78 20c96 20cb5 20ccf 20d31 20d71 20d7e-20d7f 20d9c 20da7 20e04 20e09 20e4c 20e73 20e76 20e7a 20e9d 20ea2 20ed7 20ef9 20f2d-20f2e 20f3b 20f4c 20fb4 20fea 21014 2105c 2106f 21075-21076 2107b 210c1 210d3 2113d 21145 2114f 2197c 21a34 21c2a 21df9 220c7 221a1 22acf 22b43 22bca 22c51 22c55 22c62 22cb2 22cc2 22d4c 22d67 22d8d 22dee 22f74 23231 23595 236ba 23cb7 23e89 23f80 244d3 24db8 24dea 24ea7 2512b 25148 2517e 25535 25e49 26258 266da 267cc 2688a 269f2 269fa 27285 27574 27657 27735 2775e 2789d 2797a 279a0 27a3e 27a59 27d73 28024 280bd 2815d 28207 282e2 2836d 289c0 289dc 28a0f 28b46 28b4e 28cca 28ccd 28cd2 28d99 28ee7 294e5 29720 298d1 29a4d 29d98 2a632 2a65b",
+	"zh-mo":    "3007 344c 3464 3473 347a 347d-347e 3493 3496 34a5 34bc 34c1 34c8 34df 34e4 34fb 3506 353e 3551 3561 356d 3570 3572 3577-3578 3584 3597 35a1 35a5 35ad 35bf 35c1 35c5 35c7 35ca 35ce 35d2 35d6 35db 35f1-35f3 35fb 35fe 3609 361a 3623 362d 3635 3639 3647-3649 364e 365f 367a 3681 36a5 36aa 36ac 36b0-36b1 36b5 36b9 36bc 36c1 36c3-36c5 36d3-36d4 36d6 36dd 36e5-36e6 36f5 3703 3708 370a 370d 371c 3723 3725 3730 3732-3733 373a 3740 3743 3762 376f 3797 37a0 37b9 37be 37f2 37f8 37fb 380f 3819 3820 382d 3836 3838 3863 38a0 38c3 38cc 38d1 38fa 3908 3914 3927 3932 393f 394d 3963 3980 3989-398a 3992 399b 39a1 39a4 39b8 39dc 39e2 39e5 39ec 39f8 39fb 39fe 3a01 3a03 3a06 3a17-3a18 3a29-3a2a 3a34 3a4b 3a52 3a57 3a5c 3a5e 3a66-3a67 3a97 3aab 3abd 3ade 3af0 3af2 3afb 3b0e 3b19 3b22 3b2b 3b39 3b42 3b58 3b60 3b71-3b72 3b7b-3b7c 3b80 3b96 3b99 3ba1 3bbe 3bc2 3bc4 3bd7 3bdd 3bec 3bf2-3bf3 3c0d 3c11 3c15 3c54 3ccb 3ccd 3cd1 3cd6 3cdc 3ceb 3d13 3d1d 3d32 3d46 3d4c 3d4e 3d51 3d5f 3d62 3d69-3d6a 3d6f 3d75 3d7d 3d85 3d8f 3d91 3da5 3dad 3db4 3dbf 3dc6-3dc7 3dcd 3dd3 3ddb 3deb 3df3 3df7 3dfc 3e40 3e43 3e48 3e55 3e74 3ea8-3eaa 3ead 3eb1 3eb8 3ebf 3ec2 3eca 3ecc 3ed1 3ed6-3ed7 3ede 3ee1 3ee7 3eeb 3ef0 3efa 3eff 3f04 3f0e 3f58-3f59 3f63 3f93 3fc0 3fd7 3fdc 3fe5 3fed 3ff9-3ffa 4004 4039 4045 4053 4057 4062 4065 406a 406f 40bb 40bf 40c8 40d8 40df 40fa 4103-4104 4109 410e 4132 4167 416c 416e 417f 4190 41b2 41cf 41db 41ef 41f9 4211 4240 4260 426a 427a 4294 42a2 42b5 42b9 42bc 42f4 42fb-42fc 432b 436e 4397 43ba 43c1 43d9 43df 43ed 43f2 4401-4402 4413 447a 448f 449f-44a0 44b0 44b7 44dd 44df 44e4 44ea 44f4 4503-4504 4509 4516 4527 452e 4533 453b 453f 4543 4551-4552 4555 4562 456a 4577 4585 45e9 4603 4606 460f 4615 4617 465b 467a 46cf-46d0 46f5 4718 477c 47d5 47ed 47f4 4800 480b 4871 489b 48ad 48d0 48dd 48ed 48fa 4906 491e 492a 492d 4935 493c 493e 4945 4951 4953 4965 496a 4972 4989 49a7 49df 49e5 4a0f 4a1d 4a24 4a35 4a96 4ab4 4ab8 4ad1 4ae4 4aff 4b19 4b2c 4b37 4b6f-4b70 4b72 4b7b 4b7e 4b8e 4b90 4b93 4b96-4b97 4b9d 4bbd-4bbe 4bc0 4c04 4c07 4c0e 4c3b 4c3e 4c5b 4c6d 4c77 4c7b 4c7d 4c81 4cae 4cb0 4ccd 4ce1 4ced 4d09 4d10 4d34 4d77 4d91 4d9c 4e04 4e21 4e2a 4e5a-4e5b 4e6a 4e78 4e80 4e85 4e98 4ece 4eee 4f37 4fe5 4ff9 5008 503b 50cd 510d-510e 516a 5186 519a 51a7-51a8 51b2-51b5 51c9 51ed 51f4 520b 5226-5227 5234 523c 5257 528f 52b5 52b9 52c5 52d1 5338 5374 537d 5393 53a0 53a6 53a8 53c1 53cc 53d9 53e0 53f6 53fe 5413-5414 5416 5421 544c-544d 546a 546d 548f 5493-5494 5497 54a4 54b2 54cb 54cd 54e3 5502 5513 551e 5525 5553 555d 5569 556b 5571-5572 5579 5586 5590 55a9 55b0 55ba 55bc 55d7 55de 55ec 55f0-55f1 55fb 5605 5611 561e 5622-5623 5625 562d 5643 564d 564f 5652 5654 565d 5689 5692 569f 56a1 56a4 56b1 56b9 56bf 56d6 56fd 5742 577a 57c8 57d7 57de 5803 5826 583a 5840 5869 5872-5873 58aa 58bb 58e0 58f2-58f3 58fb 590a 5975 599f 59ac 59c9 59eb 59f8 5a2b 5a7e 5af2 5afa 5b46 5b6d 5b9d 5b9f 5bc3 5bdb 5bf3 5c05 5c4a 5c5e 5cef 5d8b 5df5 5e7a 5e83 5ed0 5ef8-5ef9 5efb-5efc 5f0c-5f0e 5f5c 5fa7 5fdf 6031 6075 609e 60a4 60d7 60e3 6159 6164 617d 6187 61d0 6239 629d 62a6 62c3 62c5 62d5 6331 6379 63b9 63d1 63de 63e6 63f8 63fc 63fe 6407 6432 643a 647c 648d 6491 64b4 64dd 64e1 64e7 651e 6530 654d 6586 6589 65e3 6630 6644 664b 6667 666b 6673 668e 66f1 6725 6736 6761 6767 67a0 67b1 6803-6804 681e 6822 6898 68b6 6900 6936 6961 6973 698a 69b2 6a0b 6a2b 6ac8 6b35 6b6f 6b74 6b7a 6be1 6c37 6c39 6c5a 6ca2 6cea 6d5c 6d72 6d96 6e15 6e29 6e7c 6ed9 6edb 6edd 6f16 6f56 6f81 6fbe 6ff6 701e 702c 7081 7089 70b9 70df 70f1 7105 712b 7140 7145 714a 7151 7171 71f6 7215 7240 7282 7287 732a 732e 7341 7374 73c9 73cf 7439 743c 7448 7460 7505 7534 753b 754a 7551 7553 7560 7567 758d-758e 75b1 75b4 7602 763b 764e 7666-7667 7676 767a 770c 771e 7740 7758 7778 777a 7793 77b9 77cb 7808 7881 788d 78b1 78b8 78d7 7906 792e 7958 7962 7991 79c4 7a93 7ab0 7ac8-7ac9 7adc-7add 7aea 7b0b 7b39 7b6f 7c15 7ca6-7ca7 7cae 7cc9 7ccd 7ced 7cf9 7cfc 7d25 7d5d 7d89 7dab 7db3 7dcd 7dcf 7ddc 7e6e 7f47 7f49 7f4e 7f78 7f97 7fa3 8061 80b6 80bd 80c6 8107 8117 8137 81a5 81b6 81ef 8218 8226 8276 82a6 82aa 82f7 8318 83d3 8418 8420 8471 84ad 84bd 84e2 8503 8534 8570 8602 862f 86ef 8786 87ce 8804 882d 8846 885e 889c 88c7 88cf 8947 8987 8994 89a5 89a7 8a94 8b4c 8b81 8b83 8b90 8ccd 8cdb 8d03 8d0b 8e0e 8e2a 8e2d 8e4f 8e7e 8e80 8ead 8eda 8ee2 8ef2 8f2d 8fb5 8fba-8fbc 8ff9 9033 9056 9061 90a8 9176 9208 920e 922a 9244 9255 925d 9262 926e 92b9 92be 9307 9340 9345 9348 9369 9384-9385 9387 93ad 93bf 93f0 9404 9426-9427 9454 945b 9465 9599 95a2 95aa 9696 96a3 9721 9751 976d 97ee 97f5 9834 98b7 98c8 98e0 991c 9938 994a 994d 9962 99c5 99e1 9a10 9b2a 9b2d 9b81 9b8b 9b8e 9bed 9bf1 9bff 9c02 9c0c 9c2f 9c35 9c3a 9c45 9c5d 9c72 9d34 9d50 9d5e 9d93 9dc0 9dc4 9dc9 9dd4 9e0a 9e0c 9e90 9e95-9e96 9eaa-9eab 9eaf 9ebf 9f08 9f26 9f62 9f8e 200ca 201a4 201a9 20325 20341 2070e 20779 20c41 20c53 20c65 20c78 20c96 20cb5 20ccf 20d31 20d71 20d7e-20d7f 20d9c 20da7 20e04 20e09 20e4c 20e73 20e76 20e7a 20e9d 20ea2 20ed7 20ef9 20f2d-20f2e 20f3b 20f4c 20fb4 20fea 21014 2105c 2106f 21075-21076 2107b 210c1 210d3 2113d 21145 2114f 2197c 21a34 21c2a 21df9 220c7 221a1 22acf 22b43 22bca 22c51 22c55 22c62 22cb2 22cc2 22d4c 22d67 22d8d 22dee 22f74 23231 23595 236ba 23cb7 23e89 23f80 244d3 24db8 24dea 24ea7 2512b 25148 2517e 25535 25e49 26258 266da 267cc 2688a 269f2 269fa 27285 27574 27657 27735 2775e 2789d 2797a 279a0 27a3e 27a59 27d73 28024 280bd 2815d 28207 282e2 2836d 289c0 289dc 28a0f 28b46 28b4e 28cca 28ccd 28cd2 28d99 28ee7 294e5 29720 298d1 29a4d 29d98 2a632 2a65b",
+	"zh-sg":    "2c7 2c9 4e00-4e01 4e03 4e07-4e0e 4e10-4e11 4e13-4e16 4e18-4e1e 4e22 4e24-4e25 4e27-4e28 4e2a-4e2d 4e30 4e32 4e34 4e36 4e38-4e3b 4e3d-4e3f 4e43 4e45 4e47-4e49 4e4b-4e50 4e52-4e54 4e56 4e58-4e59 4e5c-4e61 4e66 4e69 4e70-4e71 4e73 4e7e 4e86 4e88-4e89 4e8b-4e8f 4e91-4e95 4e98 4e9a-4e9b 4e9f-4ea2 4ea4-4ea9 4eab-4eae 4eb2-4eb3 4eb5 4eba-4ebb 4ebf-4ec7 4ec9-4ecb 4ecd-4ece 4ed1 4ed3-4ed9 4edd-4edf 4ee1 4ee3-4ee5 4ee8 4eea-4eec 4ef0 4ef2-4ef3 4ef5-4ef7 4efb 4efd 4eff 4f01 4f09-4f0a 4f0d-4f11 4f17-4f1b 4f1e-4f20 4f22 4f24-4f27 4f2a-4f2b 4f2f-4f30 4f32 4f34 4f36 4f38 4f3a 4f3c-4f3d 4f43 4f46 4f4d-4f51 4f53 4f55 4f57-4f60 4f63-4f65 4f67 4f69 4f6c 4f6f-4f70 4f73-4f74 4f76 4f7b-4f7c 4f7e-4f7f 4f83-4f84 4f88-4f89 4f8b 4f8d 4f8f 4f91 4f94 4f97 4f9b 4f9d 4fa0 4fa3 4fa5-4faa 4fac 4fae-4faf 4fb5 4fbf 4fc3-4fc5 4fca 4fce-4fd1 4fd7-4fd8 4fda 4fdc-4fdf 4fe1 4fe3 4fe6 4fe8-4fea 4fed-4fef 4ff1 4ff3 4ff8 4ffa 4ffe 500c-500d 500f 5012 5014 5018-501a 501c 501f 5021 5025-5026 5028-502a 502c-502e 503a 503c 503e 5043 5047-5048 504c 504e-504f 5055 505a 505c 5065 506c 5076-5077 507b 507e-5080 5085 5088 508d 50a3 50a5 50a7-50a9 50ac 50b2 50ba-50bb 50cf 50d6 50da 50e6-50e7 50ec-50ee 50f3 50f5 50fb 5106-5107 510b 5112 5121 513f-5141 5143-5146 5148-5149 514b 514d 5151 5154-5156 515a 515c 5162 5165 5168 516b-516e 5170-5171 5173-5179 517b-517d 5180-5182 5185 5188-5189 518c-518d 5192 5195-5197 5199 519b-519c 51a0 51a2 51a4-51a5 51ab-51ac 51af-51b3 51b5-51b7 51bb-51bd 51c0 51c4 51c6-51c7 51c9 51cb-51cc 51cf 51d1 51db 51dd 51e0-51e1 51e4 51eb 51ed 51ef-51f0 51f3 51f5-51f6 51f8-51fd 51ff-5203 5206-5208 520a 520d-520e 5211-5212 5216-521b 521d 5220 5224 5228-5229 522b 522d-522e 5230 5233 5236-523b 523d 523f-5243 524a 524c-524d 5250-5251 5254 5256 525c 525e 5261 5265 5267 5269-526a 526f 5272 527d 527f 5281-5282 5288 5290 5293 529b 529d-52a3 52a8-52ad 52b1-52b3 52be-52bf 52c3 52c7 52c9 52cb 52d0 52d2 52d6 52d8 52df 52e4 52f0 52f9-52fa 52fe-5300 5305-5306 5308 530d 530f-5310 5315-5317 5319-531a 531d 5320-5321 5323 5326 532a 532e 5339-533b 533e-533f 5341 5343 5345 5347-534a 534e-534f 5351-5353 5355-5357 535a 535c 535e-5364 5366-5367 5369 536b 536e-5371 5373-5375 5377-5378 537a 537f 5382 5384-5386 5389 538b-538d 5395 5398 539a 539d 539f 53a2-53a3 53a5-53a6 53a8-53a9 53ae 53b6 53bb 53bf 53c1-53c2 53c8-53cd 53d1 53d4 53d6-53d9 53db 53df-53e0 53e3-53e6 53e8-53f3 53f5-53f9 53fb-53fd 5401 5403-5404 5406 5408-540a 540c-5413 5415-5417 541b 541d-5421 5423 5426-5429 542b-542f 5431-5432 5434-5435 5438-5439 543b-543c 543e 5440 5443 5446 5448 544a-544b 5450 5452-5459 545b-545c 5462 5464 5466 5468 5471-5473 5475-5478 547b-547d 5480 5482 5484 5486 548b-548c 548e-5490 5492 5494-5496 5499-549b 549d 54a3-54a4 54a6-54ad 54af 54b1 54b3-54b4 54b8 54bb 54bd 54bf-54c2 54c4 54c6-54c9 54cc-54d5 54d7 54d9-54da 54dc-54df 54e5-54ea 54ed-54ee 54f2-54f3 54fa 54fc-54fd 54ff 5501 5506-5507 5509 550f-5511 5514 551b 5520 5522-5524 5527 552a 552c 552e-5531 5533 5537 553c 553e-553f 5541 5543-5544 5546 5549-554a 5550 5555-5556 555c 5561 5564-5567 556a 556c-556e 5575-5578 557b-557c 557e 5580-5584 5587-558b 558f 5591 5594 5598-5599 559c-559d 559f 55a7 55b1 55b3 55b5 55b7 55b9 55bb 55bd-55be 55c4-55c5 55c9 55cc-55cd 55d1-55d4 55d6 55dc-55dd 55df 55e1 55e3-55e6 55e8 55ea-55ec 55ef 55f2-55f3 55f5 55f7 55fd-55fe 5600-5601 5608-5609 560c 560e-560f 5618 561b 561e-561f 5623-5624 5627 562c-562d 5631-5632 5634 5636 5639 563b 563f 564c-564e 5654 5657-5659 565c 5662 5664 5668-566c 5671 5676 567b-567c 5685-5686 568e-568f 5693 56a3 56af 56b7 56bc 56ca 56d4 56d7 56da-56db 56dd-56e2 56e4 56eb 56ed 56f0-56f1 56f4-56f5 56f9-56fa 56fd-56ff 5703-5704 5706 5708-570a 571c 571f 5723 5728-572a 572c-5730 5733 5739-573b 573e 5740 5742 5747 574a 574c-5751 5757 575a-5761 5764 5766 5768-576b 576d 576f 5773 5776-5777 577b-577c 5782-5786 578b-578c 5792-5793 579b 57a0-57a4 57a6-57a7 57a9 57ab 57ad-57ae 57b2 57b4 57b8 57c2-57c3 57cb 57ce-57cf 57d2 57d4-57d5 57d8-57da 57dd 57df-57e0 57e4 57ed 57ef 57f4 57f8-57fa 57fd 5800 5802 5806-5807 580b 580d 5811 5815 5819 581e 5820-5821 5824 582a 5830 5835 5844 584c-584d 5851 5854 5858 585e 5865 586b-586c 587e 5880-5881 5883 5885 5889 5892-5893 5899-589a 589e-589f 58a8-58a9 58bc 58c1 58c5 58d1 58d5 58e4 58eb-58ec 58ee 58f0 58f3 58f6 58f9 5902 5904 5907 590d 590f 5914-5916 5919-591a 591c 591f 5924-5925 5927 5929-592b 592d-592f 5931 5934 5937-593a 593c 5941-5942 5944 5947-5949 594b 594e-594f 5951 5954-5958 595a 5960 5962 5965 5973-5974 5976 5978-5979 597d 5981-5984 5986-5988 598a 598d 5992-5993 5996-5997 5999 599e 59a3-59a5 59a8-59ab 59ae-59af 59b2 59b9 59bb 59be 59c6 59ca-59cb 59d0-59d4 59d7-59d8 59da 59dc-59dd 59e3 59e5 59e8 59ec 59f9 59fb 59ff 5a01 5a03-5a09 5a0c 5a11 5a13 5a18 5a1c 5a1f-5a20 5a23 5a25 5a29 5a31-5a32 5a34 5a36 5a3c 5a40 5a46 5a49-5a4a 5a55 5a5a 5a62 5a67 5a6a 5a74-5a77 5a7a 5a7f 5a92 5a9a-5a9b 5aaa 5ab2-5ab3 5ab5 5ab8 5abe 5ac1-5ac2 5ac9 5acc 5ad2 5ad4 5ad6 5ad8 5adc 5ae0-5ae1 5ae3 5ae6 5ae9 5aeb 5af1 5b09 5b16-5b17 5b32 5b34 5b37 5b40 5b50-5b51 5b53-5b55 5b57-5b5d 5b5f 5b62-5b66 5b69-5b6a 5b6c 5b70-5b71 5b73 5b75 5b7a 5b7d 5b80-5b81 5b83-5b85 5b87-5b89 5b8b-5b8c 5b8f 5b93 5b95 5b97-5b9e 5ba0-5ba6 5baa-5bab 5bb0 5bb3-5bb6 5bb8-5bb9 5bbd-5bbf 5bc2 5bc4-5bc7 5bcc 5bd0 5bd2-5bd3 5bdd-5bdf 5be1 5be4-5be5 5be8 5bee 5bf0 5bf8-5bfc 5bff 5c01 5c04 5c06 5c09-5c0a 5c0f 5c11 5c14-5c16 5c18 5c1a 5c1c-5c1d 5c22 5c24-5c25 5c27 5c2c 5c31 5c34 5c38-5c42 5c45 5c48-5c4b 5c4e-5c51 5c55 5c59 5c5e 5c60-5c61 5c63 5c65-5c66 5c6e-5c6f 5c71 5c79-5c7a 5c7f 5c81-5c82 5c88 5c8c-5c8d 5c90-5c91 5c94 5c96-5c9c 5ca2-5ca3 5ca9 5cab-5cad 5cb1 5cb3 5cb5 5cb7-5cb8 5cbd 5cbf 5cc1 5cc4 5ccb 5cd2 5cd9 5ce1 5ce4-5ce6 5ce8 5cea 5ced 5cf0 5cfb 5d02-5d03 5d06-5d07 5d0e 5d14 5d16 5d1b 5d1e 5d24 5d26-5d27 5d29 5d2d-5d2e 5d34 5d3d-5d3e 5d47 5d4a-5d4c 5d58 5d5b 5d5d 5d69 5d6b-5d6c 5d6f 5d74 5d82 5d99 5d9d 5db7 5dc5 5dcd 5ddb 5ddd-5dde 5de1-5de2 5de5-5de9 5deb 5dee-5def 5df1-5df4 5df7 5dfd-5dfe 5e01-5e03 5e05-5e06 5e08 5e0c 5e0f-5e11 5e14-5e16 5e18-5e1d 5e26-5e27 5e2d-5e2e 5e31 5e37-5e38 5e3b-5e3d 5e42 5e44-5e45 5e4c 5e54-5e55 5e5b 5e5e 5e61-5e62 5e72-5e74 5e76 5e78 5e7a-5e7d 5e7f-5e80 5e84 5e86-5e87 5e8a-5e8b 5e8f-5e91 5e93-5e97 5e99-5e9a 5e9c 5e9e-5ea0 5ea5-5ea7 5ead 5eb3 5eb5-5eb9 5ebe 5ec9-5eca 5ed1-5ed3 5ed6 5edb 5ee8 5eea 5ef4 5ef6-5ef7 5efa 5efe-5f04 5f08 5f0a-5f0b 5f0f 5f11 5f13 5f15 5f17-5f18 5f1b 5f1f-5f20 5f25-5f27 5f29-5f2a 5f2d 5f2f 5f31 5f39-5f3a 5f3c 5f40 5f50 5f52-5f53 5f55-5f58 5f5d 5f61-5f62 5f64 5f66 5f69-5f6a 5f6c-5f6d 5f70-5f71 5f73 5f77 5f79 5f7b-5f7c 5f80-5f82 5f84-5f85 5f87-5f8c 5f90 5f92 5f95 5f97-5f99 5f9c 5fa1 5fa8 5faa 5fad-5fae 5fb5 5fb7 5fbc-5fbd 5fc3-5fc6 5fc9 5fcc-5fcd 5fcf-5fd2 5fd6-5fd9 5fdd 5fe0-5fe1 5fe4 5fe7 5fea-5feb 5fed-5fee 5ff1 5ff5 5ff8 5ffb 5ffd-6006 600a 600d-600f 6012 6014-6016 6019 601b-601d 6020-6021 6025-602b 602f 6035 603b-603c 603f 6041-6043 604b 604d 6050 6052 6055 6059-605a 605d 6062-6064 6067-606d 606f-6070 6073 6076 6078-607d 607f 6083-6084 6089 608c-608d 6092 6094 6096 609a-609b 609d 609f-60a0 60a3 60a6 60a8 60ab-60ad 60af 60b1-60b2 60b4 60b8 60bb-60bc 60c5-60c6 60ca-60cb 60d1 60d5 60d8 60da 60dc-60dd 60df-60e0 60e6-60e9 60eb-60f0 60f3-60f4 60f6 60f9-60fa 6100-6101 6106 6108-6109 610d-610f 6115 611a 611f-6120 6123-6124 6126-6127 612b 613f 6148 614a 614c 614e 6151 6155 615d 6162 6167-6168 6170 6175 6177 618b 618e 6194 619d 61a7-61a9 61ac 61b7 61be 61c2 61c8 61ca-61cb 61d1-61d2 61d4 61e6 61f5 61ff 6206 6208 620a-6212 6215-6218 621a-621b 621f 6221-6222 6224-6225 622a 622c 622e 6233-6234 6237 623d-6241 6243 6247-6249 624b-624e 6251-6254 6258 625b 6263 6266-6267 6269-6270 6273 6276 6279 627c 627e-6280 6284 6289-628a 6291-6293 6295-6298 629a-629b 629f-62a2 62a4-62a5 62a8 62ab-62ac 62b1 62b5 62b9 62bb-62bd 62bf 62c2 62c4-62ca 62cc-62ce 62d0 62d2-62d4 62d6-62dc 62df 62e2-62e3 62e5-62e9 62ec-62ef 62f1 62f3-62f4 62f6-62f7 62fc-62ff 6301-6302 6307-6309 630e 6311 6316 631a-631b 631d-6325 6328 632a-632b 632f 6332 6339-633a 633d 6342-6343 6345-6346 6349 634b-6350 6355 635e-635f 6361-6363 6367 6369 636d-636e 6371 6376-6377 637a-637b 6380 6382 6387-638a 638c 638e-6390 6392 6396 6398 63a0 63a2-63a3 63a5 63a7-63aa 63ac-63ae 63b0 63b3-63b4 63b7-63b8 63ba 63bc 63be 63c4 63c6 63c9 63cd-63d0 63d2 63d6 63de 63e0-63e1 63e3 63e9-63ea 63ed 63f2 63f4 63f6 63f8 63fd 63ff-6402 6405 640b-640c 640f-6410 6413-6414 641b-641c 641e 6420-6421 6426 642a 642c-642d 6434 643a 643d 643f 6441 6444-6448 644a 6452 6454 6458 645e 6467 6469 646d 6478-647a 6482 6484-6485 6487 6491-6492 6495-6496 6499 649e 64a4 64a9 64ac-64ae 64b0 64b5 64b7-64b8 64ba 64bc 64c0 64c2 64c5 64cd-64ce 64d0 64d2 64d7-64d8 64de 64e2 64e4 64e6 6500 6509 6512 6518 6525 652b 652e-652f 6534-6536 6538-6539 653b 653e-653f 6545 6548-6549 654c 654f 6551 6555-6556 6559 655b 655d-655e 6562-6563 6566 656b-656c 6570 6572 6574 6577 6587 658b-658c 6590-6591 6593 6597 6599 659b-659c 659f 65a1 65a4-65a5 65a7 65a9 65ab 65ad 65af-65b0 65b9 65bc-65bd 65c1 65c3-65c6 65cb-65cc 65ce-65cf 65d2 65d6-65d7 65e0 65e2 65e5-65e9 65ec-65f1 65f6-65f7 65fa 6600 6602-6603 6606 660a 660c 660e-660f 6613-6615 6619 661d 661f-6620 6625 6627-6628 662d 662f 6631 6634-6636 663c 663e 6641 6643 664b-664c 664f 6652-6657 665a 665f 6661 6664 6666 6668 666e-6670 6674 6676-6677 667a 667e 6682 6684 6687 668c 6691 6696-6697 669d 66a7-66a8 66ae 66b4 66b9 66be 66d9 66db-66dd 66e6 66e9 66f0 66f2-66f4 66f7 66f9 66fc 66fe-6700 6708-670b 670d 6710 6714-6715 6717 671b 671d 671f 6726 6728 672a-672d 672f 6731 6734-6735 673a 673d 6740 6742-6743 6746 6748-6749 674c 674e-6751 6753 6756 675c 675e-6761 6765 6768-676a 676d 676f-6770 6772-6773 6775 6777 677c 677e-677f 6781 6784 6787 6789 678b 6790 6795 6797-6798 679a 679c-679e 67a2-67a3 67a5 67a7-67a8 67aa-67ab 67ad 67af-67b0 67b3 67b5-67b8 67c1 67c3-67c4 67cf-67d4 67d8-67da 67dc-67de 67e0 67e2 67e5 67e9 67ec 67ef-67f1 67f3-67f4 67fd 67ff-6800 6805 6807-680c 680e-680f 6811 6813 6816-6817 681d 6821 6829-682a 6832-6833 6837-6839 683c-683e 6840-6846 6848-684a 684c 684e 6850-6851 6853-6855 6860-6869 686b 6874 6876-6877 6881 6883 6885-6886 688f 6893 6897 68a2 68a6-68a8 68ad 68af-68b0 68b3 68b5 68c0 68c2 68c9 68cb 68cd 68d2 68d5 68d8 68da 68e0 68e3 68ee 68f0-68f1 68f5 68f9-68fa 68fc 6901 6905 690b 690d-690e 6910 6912 691f-6920 6924 692d 6930 6934 6939 693d 693f 6942 6954 6957 695a 695d-695e 6960 6963 6966 696b 696e 6971 6977-6979 697c 6980 6982 6984 6986-6989 698d 6994-6995 6998 699b-699c 69a7-69a8 69ab 69ad 69b1 69b4 69b7 69bb 69c1 69ca 69cc 69ce 69d0 69d4 69db 69df-69e0 69ed 69f2 69fd 69ff 6a0a 6a17-6a18 6a1f 6a21 6a28 6a2a 6a2f 6a31 6a35 6a3d-6a3e 6a44 6a47 6a50 6a58-6a59 6a5b 6a61 6a65 6a71 6a79 6a7c 6a80 6a84 6a8e 6a90-6a91 6a97 6aa0 6aa9 6aab-6aac 6b20-6b24 6b27 6b32 6b37 6b39-6b3a 6b3e 6b43 6b46-6b47 6b49 6b4c 6b59 6b62-6b67 6b6a 6b79 6b7b-6b7c 6b81-6b84 6b86-6b87 6b89-6b8b 6b8d 6b92-6b93 6b96 6b9a-6b9b 6ba1 6baa 6bb3-6bb5 6bb7 6bbf 6bc1-6bc2 6bc5 6bcb 6bcd 6bcf 6bd2-6bd7 6bd9 6bdb 6be1 6bea-6beb 6bef 6bf3 6bf5 6bf9 6bfd 6c05-6c07 6c0d 6c0f-6c11 6c13-6c16 6c18-6c1b 6c1f 6c21-6c22 6c24 6c26-6c2a 6c2e-6c30 6c32 6c34-6c35 6c38 6c3d 6c40-6c42 6c46-6c47 6c49-6c4a 6c50 6c54-6c55 6c57 6c5b-6c61 6c64 6c68-6c6a 6c70 6c72 6c74 6c76 6c79 6c7d-6c7e 6c81-6c83 6c85-6c86 6c88-6c89 6c8c 6c8f-6c90 6c93-6c94 6c99 6c9b 6c9f 6ca1 6ca3-6ca7 6ca9-6cab 6cad-6cae 6cb1-6cb3 6cb8-6cb9 6cbb-6cbf 6cc4-6cc5 6cc9-6cca 6ccc 6cd0 6cd3-6cd7 6cdb 6cde 6ce0-6ce3 6ce5 6ce8 6cea-6ceb 6cee-6cf1 6cf3 6cf5-6cf8 6cfa-6cfe 6d01 6d04 6d07 6d0b-6d0c 6d0e 6d12 6d17 6d19-6d1b 6d1e 6d25 6d27 6d2a-6d2b 6d2e 6d31-6d33 6d35 6d39 6d3b-6d3e 6d41 6d43 6d45-6d48 6d4a-6d4b 6d4d-6d4f 6d51-6d54 6d59-6d5a 6d5c 6d5e 6d60 6d63 6d66 6d69-6d6a 6d6e-6d6f 6d74 6d77-6d78 6d7c 6d82 6d85 6d88-6d89 6d8c 6d8e 6d91 6d93-6d95 6d9b 6d9d-6da1 6da3-6da4 6da6-6dab 6dae-6daf 6db2 6db5 6db8 6dbf-6dc0 6dc4-6dc7 6dcb-6dcc 6dd1 6dd6 6dd8-6dd9 6ddd-6dde 6de0-6de1 6de4 6de6 6deb-6dec 6dee 6df1 6df3 6df7 6df9 6dfb-6dfc 6e05 6e0a 6e0c-6e0e 6e10-6e11 6e14 6e16-6e17 6e1a 6e1d 6e20-6e21 6e23-6e25 6e29 6e2b 6e2d 6e2f 6e32 6e34 6e38 6e3a 6e43-6e44 6e4d-6e4e 6e53-6e54 6e56 6e58 6e5b 6e5f 6e6b 6e6e 6e7e-6e7f 6e83 6e85-6e86 6e89 6e8f-6e90 6e98 6e9c 6e9f 6ea2 6ea5 6ea7 6eaa 6eaf 6eb1-6eb2 6eb4 6eb6-6eb7 6eba-6ebb 6ebd 6ec1-6ec2 6ec7 6ecb 6ecf 6ed1 6ed3-6ed5 6ed7 6eda 6ede-6ee2 6ee4-6ee6 6ee8-6ee9 6ef4 6ef9 6f02 6f06 6f09 6f0f 6f13-6f15 6f20 6f24 6f29-6f2b 6f2d 6f2f 6f31 6f33 6f36 6f3e 6f46-6f47 6f4b 6f4d 6f58 6f5c 6f5e 6f62 6f66 6f6d-6f6e 6f72 6f74 6f78 6f7a 6f7c 6f84 6f88-6f89 6f8c-6f8e 6f9c 6fa1 6fa7 6fb3 6fb6 6fb9 6fc0 6fc2 6fc9 6fd1-6fd2 6fde 6fe0-6fe1 6fee-6fef 7011 701a-701b 7023 7035 7039 704c 704f 705e 706b-706d 706f-7070 7075-7076 7078 707c 707e-7080 7085 7089-708a 708e 7092 7094-7096 7099 709c-709d 70ab-70af 70b1 70b3 70b7-70b9 70bb-70bd 70c0-70c3 70c8 70ca 70d8-70d9 70db 70df 70e4 70e6-70e9 70eb-70ed 70ef 70f7 70f9 70fd 7109-710a 7110 7113 7115-7116 7118-711a 7126 712f-7131 7136 7145 714a 714c 714e 715c 715e 7164 7166-7168 716e 7172-7173 7178 717a 717d 7184 718a 718f 7194 7198-7199 719f-71a0 71a8 71ac 71b3 71b5 71b9 71c3 71ce 71d4-71d5 71e0 71e5 71e7 71ee 71f9 7206 721d 7228 722a 722c 7230-7231 7235-7239 723b 723d 723f 7247-7248 724c-724d 7252 7256 7259 725b 725d 725f 7261-7262 7266-7267 7269 726e-726f 7272 7275 7279-727a 727e-7281 7284 728a-728b 728d 728f 7292 729f 72ac-72ad 72af-72b0 72b4 72b6-72b9 72c1-72c4 72c8 72cd-72ce 72d0 72d2 72d7 72d9 72de 72e0-72e1 72e8-72e9 72ec-72f4 72f7-72f8 72fa-72fc 7301 7303 730a 730e 7313 7315-7317 731b-731e 7321-7322 7325 7329-732c 732e 7331 7334 7337-7339 733e-733f 734d 7350 7352 7357 7360 736c-736d 736f 737e 7384 7387 7389 738b 738e 7391 7396 739b 739f 73a2 73a9 73ab 73ae-73b0 73b2-73b3 73b7 73ba-73bb 73c0 73c2 73c8-73ca 73cd 73cf-73d1 73d9 73de 73e0 73e5 73e7 73e9 73ed 73f2 7403 7405-7406 7409-740a 740f-7410 741a-741b 7422 7425-7426 7428 742a 742c 742e 7430 7433-7436 743c 7441 7455 7457 7459-745c 745e-745f 746d 7470 7476-7477 747e 7480-7481 7483 7487 748b 748e 7490 749c 749e 74a7-74a9 74ba 74d2 74dc 74de 74e0 74e2-74e4 74e6 74ee-74ef 74f4 74f6-74f7 74ff 7504 750d 750f 7511 7513 7518-751a 751c 751f 7525 7528-7529 752b-752d 752f-7533 7535 7537-7538 753a-753b 753e 7540 7545 7548 754b-754c 754e-754f 7554 7559-755c 7565-7566 756a 7572 7574 7578-7579 757f 7583 7586 758b 758f 7591-7592 7594 7596-7597 7599-759a 759d 759f-75a1 75a3-75a5 75ab-75ac 75ae-75b5 75b8-75b9 75bc-75be 75c2-75c5 75c7-75ca 75cd 75d2 75d4-75d6 75d8 75db 75de 75e2-75e4 75e6-75e8 75ea-75eb 75f0-75f1 75f4 75f9 75fc 75ff-7601 7603 7605 760a 760c 7610 7615 7617-7619 761b 761f-7620 7622 7624-7626 7629-762b 762d 7630 7633-7635 7638 763c 763e-7640 7643 764c-764d 7654 7656 765c 765e 7663 766b 766f 7678 767b 767d-767e 7682 7684 7686-7688 768b 768e 7691 7693 7696 7699 76a4 76ae 76b1-76b2 76b4 76bf 76c2 76c5-76c6 76c8 76ca 76cd-76d2 76d4 76d6-76d8 76db 76df 76e5 76ee-76ef 76f1-76f2 76f4 76f8-76f9 76fc 76fe 7701 7704 7707-7709 770b 770d 7719-771a 771f-7720 7722 7726 7728-7729 772d 772f 7735-7738 773a 773c 7740-7741 7743 7747 7750-7751 775a-775b 7761-7763 7765-7766 7768 776b-776c 7779 777d-7780 7784-7785 778c-778e 7791-7792 779f-77a0 77a2 77a5 77a7 77a9-77aa 77ac 77b0 77b3 77b5 77bb 77bd 77bf 77cd 77d7 77db-77dc 77e2-77e3 77e5 77e7 77e9 77eb-77ee 77f3 77f6 77f8 77fd-7802 7809 780c-780d 7811-7812 7814 7816-7818 781a 781c-781d 781f 7823 7825-7827 7829 782c-782d 7830 7834 7837-783c 783e 7840 7845 7847 784c 784e 7850 7852 7855-7857 785d 786a-786e 7877 787c 7887 7889 788c-788e 7891 7893 7897-7898 789a-789c 789f 78a1 78a3 78a5 78a7 78b0-78b4 78b9 78be 78c1 78c5 78c9-78cb 78d0 78d4-78d5 78d9 78e8 78ec 78f2 78f4 78f7 78fa 7901 7905 7913 791e 7924 7934 793a-793c 793e 7940-7941 7946 7948-7949 7953 7956-7957 795a-7960 7962 7965 7967-7968 796d 796f 7977-7978 797a 7980-7981 7984-7985 798a 798f 799a 79a7 79b3 79b9-79bb 79bd-79be 79c0-79c1 79c3 79c6 79c9 79cb 79cd 79d1-79d2 79d5 79d8 79df 79e3-79e4 79e6-79e7 79e9 79eb 79ed 79ef-79f0 79f8 79fb 79fd 7a00 7a02-7a03 7a06 7a0b 7a0d-7a0e 7a14 7a17 7a1a 7a1e 7a20 7a23 7a33 7a37 7a39 7a3b-7a3d 7a3f 7a46 7a51 7a57 7a70 7a74 7a76-7a7a 7a7f-7a81 7a83-7a84 7a86 7a88 7a8d 7a91-7a92 7a95-7a98 7a9c-7a9d 7a9f-7aa0 7aa5-7aa6 7aa8 7aac-7aad 7ab3 7abf 7acb 7ad6 7ad9 7ade-7ae0 7ae3 7ae5-7ae6 7aed 7aef 7af9-7afa 7afd 7aff 7b03-7b04 7b06 7b08 7b0a-7b0b 7b0f 7b11 7b14-7b15 7b19 7b1b 7b1e 7b20 7b24-7b26 7b28 7b2a-7b2c 7b2e 7b31 7b33 7b38 7b3a 7b3c 7b3e 7b45 7b47 7b49 7b4b-7b4c 7b4f-7b52 7b54 7b56 7b58 7b5a-7b5b 7b5d 7b60 7b62 7b6e 7b71-7b72 7b75 7b77 7b79 7b7b 7b7e 7b80 7b85 7b8d 7b90 7b94-7b95 7b97 7b9c-7b9d 7ba1-7ba2 7ba6-7bad 7bb1 7bb4 7bb8 7bc1 7bc6-7bc7 7bcc 7bd1 7bd3 7bd9-7bda 7bdd 7be1 7be5-7be6 7bea 7bee 7bf1 7bf7 7bfc 7bfe 7c07 7c0b-7c0c 7c0f 7c16 7c1f 7c26-7c27 7c2a 7c38 7c3f-7c41 7c4d 7c73-7c74 7c7b-7c7d 7c89 7c91-7c92 7c95 7c97-7c98 7c9c-7c9f 7ca2 7ca4-7ca5 7caa 7cae 7cb1-7cb3 7cb9 7cbc-7cbe 7cc1 7cc5 7cc7-7cc8 7cca 7ccc-7ccd 7cd5-7cd7 7cd9 7cdc 7cdf-7ce0 7ce8 7cef 7cf8 7cfb 7d0a 7d20 7d22 7d27 7d2b 7d2f 7d6e 7d77 7da6 7dae 7e3b 7e41 7e47 7e82 7e9b 7e9f-7ead 7eaf-7eb3 7eb5-7eba 7ebd-7ed5 7ed7-7ee3 7ee5-7eeb 7eed-7ef8 7efa-7f09 7f0b-7f0f 7f11-7f1d 7f1f-7f36 7f38 7f3a 7f42 7f44-7f45 7f50-7f51 7f54-7f55 7f57-7f58 7f5a 7f5f 7f61-7f62 7f68-7f6a 7f6e 7f71-7f72 7f74 7f79 7f7e 7f81 7f8a 7f8c 7f8e 7f94 7f9a 7f9d-7f9f 7fa1 7fa4 7fa7 7faf-7fb0 7fb2 7fb8-7fb9 7fbc-7fbd 7fbf 7fc1 7fc5 7fca 7fcc 7fce 7fd4-7fd5 7fd8 7fdf-7fe1 7fe5-7fe6 7fe9 7fee 7ff0-7ff1 7ff3 7ffb-7ffc 8000-8001 8003-8006 800b-800d 8010 8012 8014-8019 801c 8020 8022 8025-802a 8031 8033 8035-8038 803b 803d 803f 8042-8043 8046 804a-804d 8052 8054 8058 805a 8069-806a 8071 807f-8080 8083-8084 8086-8087 8089 808b-808c 8093 8096 8098 809a-809d 809f-80a2 80a4-80a5 80a9-80ab 80ad-80af 80b1-80b2 80b4 80b7 80ba 80bc-80c4 80c6 80cc-80ce 80d6-80d7 80d9-80de 80e1 80e4-80e5 80e7-80ed 80ef-80f4 80f6 80f8 80fa 80fc-80fd 8102 8106 8109-810a 810d-8114 8116 8118 811a 811e 812c 812f 8131-8132 8136 8138 813e 8146 8148 814a-814c 8150-8151 8153-8155 8159-815a 8160 8165 8167 8169 816d-816e 8170-8171 8174 8179-8180 8182 8188 818a 818f 8191 8198 819b-819d 81a3 81a6 81a8 81aa 81b3 81ba-81bb 81c0-81c3 81c6 81ca 81cc 81e3 81e7 81ea 81ec-81ed 81f3-81f4 81fb-81fc 81fe 8200-8202 8204-8206 820c-820d 8210 8212 8214 821b-821c 821e-821f 8221-8223 8228 822a-822d 822f-8231 8233-8239 823b 823e 8244 8247 8249 824b 824f 8258 825a 825f 8268 826e-8270 8272-8274 8279-827a 827d-827f 8282 8284 8288 828a-828b 828d-828f 8291-8292 8297-8299 829c-829d 829f 82a1 82a4-82a6 82a8-82b1 82b3-82b4 82b7-82b9 82bd-82be 82c1 82c4 82c7-82c8 82ca-82cf 82d1-82d5 82d7-82d8 82db-82dc 82de-82e1 82e3-82e6 82eb 82ef 82f1 82f4 82f7 82f9 82fb 8301-8309 830c 830e-830f 8311 8314-8315 8317 831a-831c 8327-8328 832b-832d 832f 8331 8333-8336 8338-833a 833c 8340 8343 8346-8347 8349 834f-8352 8354 835a-835c 835e-8361 8363-836f 8377-8378 837b-837d 8385-8386 8389 838e 8392-8393 8398 839b-839c 839e 83a0 83a8-83ab 83b0-83b4 83b6-83ba 83bc-83bd 83c0-83c1 83c5 83c7 83ca 83cc 83cf 83d4 83d6 83d8 83dc-83dd 83df-83e1 83e5 83e9-83ea 83f0-83f2 83f8-83f9 83fd 8401 8403-8404 8406 840b-840f 8411 8418 841c-841d 8424-8428 8431 8438 843c-843d 8446 8451 8457 8459-845c 8461 8463 8469 846b-846d 8471 8473 8475-8476 8478 847a 8482 8487-8489 848b-848c 848e 8497 8499 849c 84a1 84af 84b2 84b4 84b8-84ba 84bd 84bf 84c1 84c4 84c9-84ca 84cd 84d0-84d1 84d3 84d6 84dd 84df-84e0 84e3 84e5-84e6 84ec 84f0 84fc 84ff 850c 8511 8513 8517 851a 851f 8521 852b-852c 8537-853d 8543 8548-854a 8556 8559 855e 8564 8568 8572 8574 8579-857b 857e 8584-8585 8587 858f 859b-859c 85a4 85a8 85aa 85ae-85b0 85b7 85b9 85c1 85c9 85cf-85d0 85d3 85d5 85dc 85e4 85e9 85fb 85ff 8605 8611 8616 8627 8629 8638 863c 864d-8651 8654 865a 865e 8662 866b-866c 866e 8671 8679-8682 868a-868d 8693 8695 869c-869d 86a3-86a4 86a7-86aa 86ac 86af-86b1 86b4-86b6 86ba 86c0 86c4 86c6-86c7 86c9-86cb 86ce-86d1 86d4 86d8-86d9 86db 86de-86df 86e4 86e9 86ed-86ee 86f0-86f4 86f8-86f9 86fe 8700 8702-8703 8707-870a 870d 8712-8713 8715 8717-8718 871a 871c 871e 8721-8723 8725 8729 872e 8731 8734 8737 873b 873e-873f 8747-8749 874c 874e 8753 8757 8759 8760 8763-8765 876e 8770 8774 8776 877b-877e 8782-8783 8785 8788 878b 878d 8793 8797 879f 87a8 87ab-87ad 87af 87b3 87b5 87ba 87bd 87c0 87c6 87ca-87cb 87d1-87d3 87db 87e0 87e5 87ea 87ee 87f9 87fe 8803 880a 8813 8815-8816 881b 8821-8822 8832 8839 883c 8840 8844-8845 884c-884d 8854 8857 8859 8861-8865 8868-8869 886b-886c 886e 8870 8872 8877 887d-887f 8881-8882 8884-8885 8888 888b 888d 8892 8896 889c 88a2 88a4 88ab 88ad 88b1 88b7 88bc 88c1-88c2 88c5-88c6 88c9 88ce 88d2 88d4-88d5 88d8-88d9 88df 88e2-88e5 88e8 88f0-88f1 88f3-88f4 88f8-88f9 88fc 88fe 8902 890a 8910 8912-8913 8919-891b 8921 8925 892a-892b 8930 8934 8936 8941 8944 895e-895f 8966 897b 897f 8981 8983 8986 89c1-89c2 89c4-89cc 89ce-89d2 89d6 89da 89dc 89de 89e3 89e5-89e6 89eb 89ef 89f3 8a00 8a07 8a3e 8a48 8a79 8a89-8a8a 8a93 8b07 8b26 8b66 8b6c 8ba0-8bab 8bad-8bb0 8bb2-8bba 8bbc-8bc6 8bc8-8bcf 8bd1-8be9 8beb-8c08 8c0a-8c1d 8c1f-8c37 8c41 8c46-8c47 8c49 8c4c 8c55 8c5a 8c61-8c62 8c6a-8c6b 8c73 8c78-8c7a 8c82 8c85 8c89-8c8a 8c8c 8c94 8c98 8d1d-8d1f 8d21-8d50 8d53-8d56 8d58-8d5e 8d60-8d64 8d66-8d67 8d6b 8d6d 8d70 8d73-8d77 8d81 8d84-8d85 8d8a-8d8b 8d91 8d94 8d9f 8da3 8db1 8db3-8db5 8db8 8dba 8dbc 8dbe-8dbf 8dc3-8dc4 8dc6 8dcb-8dcc 8dce-8dcf 8dd1 8dd6-8dd7 8dda-8ddb 8ddd-8ddf 8de3-8de4 8de8 8dea-8dec 8def 8df3 8df5 8df7-8dfb 8dfd 8e05 8e09-8e0a 8e0c 8e0f 8e14 8e1d-8e1f 8e22-8e23 8e29-8e2a 8e2c 8e2e-8e2f 8e31 8e35 8e39-8e3a 8e3d 8e40-8e42 8e44 8e47-8e4b 8e51-8e52 8e59 8e66 8e69 8e6c-8e6d 8e6f-8e70 8e72 8e74 8e76 8e7c 8e7f 8e81 8e85 8e87 8e8f-8e90 8e94 8e9c 8e9e 8eab-8eac 8eaf 8eb2 8eba 8ece 8f66-8f69 8f6b-8f7f 8f81-8f8b 8f8d-8f91 8f93-8f9c 8f9e-8f9f 8fa3 8fa8-8fa9 8fab 8fb0-8fb1 8fb6 8fb9 8fbd-8fbe 8fc1-8fc2 8fc4-8fc5 8fc7-8fc8 8fce 8fd0-8fd1 8fd3-8fd5 8fd8-8fd9 8fdb-8fdf 8fe2 8fe4-8fe6 8fe8-8feb 8fed-8fee 8ff0 8ff3 8ff7-8ff9 8ffd 9000-9006 9009-900b 900d 900f-9012 9014 9016-9017 901a-901b 901d-9022 9026 902d-902f 9035-9036 9038 903b-903c 903e 9041-9042 9044 9047 904d 904f-9053 9057-9058 905b 9062-9063 9065 9068 906d-906e 9074-9075 907d 907f-9080 9082-9083 9088 908b 9091 9093 9095 9097 9099 909b 909d 90a1-90a3 90a6 90aa 90ac 90ae-90b1 90b3-90b6 90b8-90bb 90be 90c1 90c4-90c5 90c7 90ca 90ce-90d1 90d3 90d7 90db-90dd 90e1-90e2 90e6-90e8 90eb 90ed 90ef 90f4 90f8 90fd-90fe 9102 9104 9119 911e 9122-9123 912f 9131 9139 9143 9146 9149-9150 9152 9157 915a 915d-915e 9161-9165 9169-916a 916c 916e-9172 9174-9179 917d-917f 9185 9187 9189 918b-918d 9190-9192 919a-919b 91a2-91a3 91aa 91ad-91af 91b4-91b5 91ba 91c7 91c9-91ca 91cc-91cf 91d1 91dc 9274 928e 92ae 92c8 933e 936a 938f 93ca 93d6 943e 946b 9485-9490 9492-9495 9497 9499-94c6 94c8-94ce 94d0-94d2 94d5-94d9 94db-94e5 94e7-94fa 94fc-951b 951d-951f 9521-9526 9528-9532 9534-953c 953e-9542 9544-9547 9549-954a 954c-9554 9556-9559 955b-955f 9561-956d 956f-9573 9576 957f 95e8-95eb 95ed-95fe 9600-9606 9608-9612 9614-9617 9619-961a 961c-961d 961f 9621-9622 962a 962e 9631-9636 963b-963d 963f-9640 9642 9644-9649 964b-964d 9650 9654-9655 965b 965f 9661-9662 9664 9667-966a 966c 9672 9674-9677 9685-9686 9688 968b 968d 968f-9690 9694 9697-9699 969c 96a7 96b0 96b3 96b6 96b9 96bc-96be 96c0-96c1 96c4-96c7 96c9 96cc-96cf 96d2 96d5 96e0 96e8-96ea 96ef 96f3 96f6-96f7 96f9 96fe 9700-9701 9704 9706-9709 970d-970f 9713 9716 971c 971e 972a 972d 9730 9732 9738-9739 973e 9752-9753 9756 9759 975b 975e 9760-9762 9765 9769 9773-9774 9776 977c 9785 978b 978d 9791-9792 9794 9798 97a0 97a3 97ab 97ad 97af 97b2 97b4 97e6-97e7 97e9-97ed 97f3 97f5-97f6 9875-988a 988c-988d 988f-9891 9893-9894 9896-9898 989a-98a2 98a4-98a7 98ce 98d1-98d3 98d5 98d8-98da 98de-98df 98e7-98e8 990d 9910 992e 9954-9955 9963 9965 9967-9972 9974-9977 997a 997c-997d 997f-9981 9984-9988 998a-998b 998d 998f-9999 99a5 99a8 9a6c-9a71 9a73-9a82 9a84-9a88 9a8a-9a8c 9a8f-9a93 9a96-9a98 9a9a-9aa5 9aa7-9aa8 9ab0-9ab1 9ab6-9ab8 9aba 9abc 9ac0-9ac2 9ac5 9acb-9acc 9ad1 9ad3 9ad8 9adf 9ae1 9ae6 9aeb 9aed 9aef 9af9 9afb 9b03 9b08 9b0f 9b13 9b1f 9b23 9b2f 9b32 9b3b-9b3c 9b41-9b45 9b47-9b49 9b4d 9b4f 9b51 9b54 9c7c 9c7f 9c81-9c82 9c85-9c88 9c8b 9c8d-9c8e 9c90-9c92 9c94-9c95 9c9a-9c9c 9c9e-9ca9 9cab 9cad-9cae 9cb0-9cb8 9cba-9cbd 9cc3-9cc7 9cca-9cd0 9cd3-9cd9 9cdc-9cdf 9ce2 9e1f-9e23 9e25-9e26 9e28-9e2d 9e2f 9e31-9e33 9e35-9e3a 9e3d-9e3f 9e41-9e4c 9e4e-9e4f 9e51 9e55 9e57-9e58 9e5a-9e5c 9e5e 9e63-9e64 9e66-9e6d 9e70-9e71 9e73 9e7e-9e7f 9e82 9e87-9e88 9e8b 9e92-9e93 9e9d 9e9f 9ea6 9eb4 9eb8 9ebb 9ebd-9ebe 9ec4 9ec9 9ecd-9ecf 9ed1 9ed4 9ed8 9edb-9edd 9edf-9ee0 9ee2 9ee5 9ee7 9ee9-9eea 9eef 9ef9 9efb-9efc 9efe 9f0b 9f0d-9f0e 9f10 9f13 9f17 9f19 9f20 9f22 9f2c 9f2f 9f37 9f39 9f3b 9f3d-9f3e 9f44 9f50-9f51 9f7f-9f80 9f83-9f8c 9f99-9f9b 9f9f-9fa0",
+	"zh-tw":    "4e00-4e01 4e03 4e07-4e11 4e14-4e16 4e18-4e19 4e1e-4e1f 4e26 4e2b 4e2d-4e2e 4e30-4e33 4e38-4e39 4e3b-4e3c 4e42-4e43 4e45 4e47-4e48 4e4b 4e4d-4e4f 4e52-4e53 4e56 4e58-4e59 4e5c-4e5f 4e69 4e73 4e7e-4e7f 4e82-4e84 4e86 4e88 4e8b-4e8e 4e91-4e95 4e99 4e9b 4e9e-4e9f 4ea1-4ea2 4ea4-4ea6 4ea8 4eab-4eae 4eb3 4eb6 4eb9-4eba 4ec0-4ec4 4ec6-4ecb 4ecd 4ed4-4eda 4edc-4edf 4ee1 4ee3-4ee5 4ee8-4ee9 4ef0-4ef7 4efb 4efd 4eff-4f02 4f04-4f05 4f08-4f0b 4f0d-4f15 4f18-4f19 4f1d 4f22 4f2c-4f2d 4f2f-4f30 4f33-4f34 4f36 4f38 4f3a-4f3f 4f41 4f43 4f46-4f49 4f4c-4f64 4f67 4f69-4f6c 4f6e-4f70 4f73-4f89 4f8b 4f8d 4f8f-4f92 4f94-4f98 4f9a-4f9e 4fae-4faf 4fb2-4fb3 4fb5-4fb7 4fb9-4fbb 4fbf-4fc5 4fc7 4fc9-4fcb 4fcd-4fd1 4fd3-4fd4 4fd6-4fe1 4fec 4fee-4fef 4ff1 4ff3-4ff8 4ffa 4ffe 5000 5005-5007 5009 500b-500f 5011-501c 501e-5023 5025-502d 502f-5031 5033 5035 5037 503c 5040-5041 5043 5045-504f 5051 5053 5055 5057 505a-5065 5068-506b 506d-5070 5072-5077 507a 507d 5080 5082-5083 5085 5087 508b-508e 5091-5092 5094-5096 5098-509e 50a2-50a3 50ac-50b8 50ba-50bb 50bd-50bf 50c1-50c2 50c4-50cb 50ce-50cf 50d1 50d3-50d7 50da-50db 50dd 50e0 50e3-50ea 50ec-50f1 50f3 50f5-50f6 50f8-50f9 50fb 50fd-5100 5102-510c 5110-5115 5117-5118 511a 511c 511f-5122 5124-5126 5129-512a 512d-512e 5130-5135 5137-513d 513f-5141 5143-5149 514b-514d 5152 5154-5155 5157 5159-515f 5161-5163 5165 5167-5169 516b-516e 5171 5175-5178 517c 5180 5187 5189-518a 518d 518f 5191-5195 5197-5198 519e 51a0 51a2 51a4-51a5 51aa 51ac 51b0-51b1 51b6-51b7 51b9 51bc-51be 51c4-51c6 51c8 51ca-51ce 51d0 51d4 51d7-51d8 51dc-51de 51e0-51e1 51f0-51f1 51f3 51f5-51f6 51f8-51fa 51fd 5200-5201 5203 5206-520a 520c 520e 5210-5213 5216-5217 521c-521e 5221 5224-5225 5228-522a 522e 5230-5233 5235-5238 523a-523b 5241 5243-5244 5246-5247 5249-524e 5252 5254-5256 525a-525f 5261-5262 5269-526f 5272 5274-5275 5277-5278 527a-527d 527f-5284 5287-528d 5291 5293 5296-5299 529b 529f-52a0 52a3 52a6 52a9-52ae 52bb-52bc 52be 52c0-52c3 52c7 52c9 52cd 52d2-52d3 52d5-52d9 52db 52dd-52df 52e2-52e4 52e6 52e9 52eb 52ef-52f1 52f3-52f5 52f7-52f8 52fa-52fc 52fe-52ff 5305-5306 5308-530b 530d-5312 5315-5317 5319-531a 531c-531d 531f-5323 532a 532d 532f-5331 5334 5337 5339 533c-5341 5343-5345 5347-534a 534c-534d 5351-5354 5357 535a 535c 535e 5360-5361 5363 5366 536c 536e-5373 5375 5377-5379 537b-537c 537f 5382 5384 538a 538e-538f 5392 5394 5396-539a 539c-539f 53a4-53a5 53a7 53ac-53ad 53b2 53b4 53b9 53bb 53c3 53c8-53cb 53cd 53d4 53d6-53d7 53db 53df 53e1-53e6 53e8-53f3 53f5 53f8 53fb-53fc 5401 5403-5404 5406-5412 5418-5419 541b-5420 5424-542e 5430-5431 5433 5435-5439 543b-543e 5440-5443 5445-5448 544a 544e-544f 5454 5460-5468 546b-546c 546f-5478 547a-5482 5484 5486-5488 548b-548e 5490-5492 5495-5496 5498 549a 54a0-54a2 54a5-54b1 54b3 54b6-54b8 54ba-54c9 54ce-54cf 54d6 54de 54e0-54e2 54e4-54eb 54ed-54ee 54f1-54f3 54f7-54f8 54fa-54fd 54ff 5501 5503-550c 550e-5512 5514 5517 551a 5526-5527 552a 552c-5539 553b-553c 553e 5540-5541 5543-5546 5548 554a-554b 554d-5552 5555-5557 555c 555e-555f 5561-5566 556a 5575-5577 557b-5584 5587-558f 5591-5595 5598-559a 559c-559d 559f 55a1-55a8 55aa-55ae 55b1-55b3 55b5 55bb 55bf-55c0 55c2-55d6 55d9-55dd 55df 55e1-55e9 55ef 55f2 55f6-55f7 55f9-55fa 55fc-5602 5604 5606 5608-5609 560c-5610 5612-5617 561b-561d 561f 5627 5629-562a 562c 562e-5630 5632-5636 5638-563b 563d-5642 5645-5646 5648-564a 564c 564e 5653 5657-565a 565e 5660 5662-5666 5668-5674 5676-5679 567e-5687 568c-5690 5693 5695 5697-569a 569c-569d 56a5-56a8 56aa-56ae 56b2-56b7 56bc-56be 56c0-56c3 56c5-56c6 56c8-56cd 56d1 56d3-56d4 56d7 56da-56db 56dd-56e1 56e4-56e5 56e7 56ea-56eb 56ee 56f0 56f7 56f9-56fa 56ff 5701-5704 5707-570d 5712-5714 5716 5718 571a-571c 571e-5720 5722-5723 5728-572a 572c-5730 5733-5734 573b 573e 5740-5741 5745 5747 5749-5752 5761-5762 5764 5766 5768-576b 576d 576f-5777 577b-577d 5780 5782-5783 578b-578c 578f 5793-5795 5797-579b 579d-57a0 57a2-57a5 57ae 57b5-57b6 57b8-57ba 57bc-57bd 57bf 57c1-57c3 57c6-57c7 57cb-57cc 57ce-57d0 57d2 57d4-57d5 57dc 57df-57e5 57e7 57e9 57ec-57ee 57f0-57fd 5800-5802 5804-580e 5810 5814 5819 581b-581e 5820-5821 5823-5825 5827-582a 582c-5839 583b 583d 583f 5848-584f 5851-5855 5857-585b 585d-585e 5862-5865 5868 586b 586d 586f 5871 5874-5876 5879-5883 5885-588b 588e-5891 5893-5894 5898 589c-58a1 58a3 58a5-58a6 58a8-58a9 58ab-58ac 58ae-58af 58b1 58b3 58ba 58bc-58bf 58c1-58c2 58c5-58c9 58ce-58cf 58d1-58d6 58d8-58db 58dd-58df 58e2-58e4 58e7-58e9 58eb-58ec 58ef 58f4 58f9-58fa 58fc-58ff 5903 5906 590c-590f 5912 5914-5917 5919-591a 591c 5920 5922 5924-5925 5927 5929-592f 5931 5937-5938 593c 593e 5940 5944-5945 5947-594a 594e-5951 5953-5955 5957-5958 595a 595c 5960-5962 5967 5969-596b 596d-596e 5970-5974 5976-5979 597b-5985 598a 598d-5990 5992-5993 5996-5999 599d-599e 59a0-59a8 59ae-59af 59b1-59b6 59b9-59be 59c0-59c1 59c3 59c5-59c8 59ca-59d4 59d6 59d8 59da-59de 59e0-59e1 59e3-59e6 59e8-59ea 59ec-59ee 59f1-59f7 59fa-5a01 5a03 5a09-5a0a 5a0c 5a0f 5a11 5a13 5a15-5a19 5a1b-5a1c 5a1e-5a20 5a23 5a25 5a29 5a2d-5a2e 5a33 5a35-5a39 5a3c 5a3e 5a40-5a44 5a46-5a4a 5a4c-5a4d 5a50-5a53 5a55-5a58 5a5a-5a60 5a62 5a64-5a67 5a69-5a6a 5a6c-5a6d 5a70 5a77-5a78 5a7a-5a7d 5a7f 5a83-5a84 5a8a-5a8c 5a8e-5a90 5a92-5a95 5a97 5a9a-5a9f 5aa2 5aa5-5aa7 5aa9 5aac 5aae-5ac2 5ac4 5ac6-5acd 5ad5-5ae3 5ae5-5ae6 5ae8-5aee 5af3-5af9 5afb 5afd 5aff 5b01-5b03 5b05 5b07-5b09 5b0b-5b0c 5b0f-5b10 5b13-5b14 5b16-5b17 5b19-5b1b 5b1d-5b1e 5b20-5b21 5b23-5b28 5b2a 5b2c-5b30 5b32 5b34 5b38 5b3c-5b40 5b43 5b45 5b47-5b48 5b4b-5b4e 5b50-5b51 5b53-5b58 5b5a-5b5d 5b5f 5b62-5b65 5b69 5b6b-5b6c 5b6e 5b70-5b73 5b75 5b77-5b78 5b7a-5b7b 5b7d 5b7f 5b81 5b83-5b85 5b87-5b89 5b8b-5b8c 5b8e-5b8f 5b92-5b93 5b95 5b97-5b9c 5ba2-5ba8 5bac-5bae 5bb0 5bb3-5bb6 5bb8-5bb9 5bbf-5bc2 5bc4-5bc7 5bca-5bce 5bd0-5bd4 5bd6 5bd8-5bd9 5bde-5bec 5bee-5bf2 5bf5-5bf6 5bf8 5bfa 5c01 5c03-5c04 5c07-5c12 5c15-5c16 5c1a 5c1f 5c22 5c24-5c25 5c28 5c2a 5c2c 5c30-5c31 5c33 5c37-5c3c 5c3e-5c41 5c44-5c48 5c4b-5c51 5c54-5c56 5c58-5c59 5c5c-5c5d 5c60 5c62-5c65 5c67-5c6a 5c6c-5c6f 5c71 5c73-5c74 5c79-5c7c 5c7e 5c86 5c88-5c8d 5c8f-5c95 5c9d 5c9f-5cb1 5cb3 5cb5-5cb8 5cc6-5ccc 5cce-5cd0 5cd2-5cd4 5cd6-5cdb 5cde-5cdf 5ce8 5cea 5cec-5cee 5cf0-5cf1 5cf4 5cf6-5cf9 5cfb 5cfd 5cff-5d01 5d06-5d07 5d0b-5d0f 5d11-5d12 5d14 5d16-5d17 5d19-5d1b 5d1d-5d20 5d22-5d29 5d2e 5d30-5d3a 5d3c-5d3d 5d3f-5d43 5d45 5d47 5d49-5d4c 5d4e 5d50-5d52 5d55 5d59 5d5e 5d62-5d63 5d65 5d67-5d69 5d6b-5d6c 5d6f 5d71-5d72 5d77 5d79-5d7a 5d7c-5d82 5d84 5d86-5d8a 5d8d 5d92-5d95 5d97 5d99-5d9a 5d9c-5da2 5da7-5daa 5dac-5db2 5db4-5db5 5db7-5db8 5dba 5dbc-5dbd 5dc0 5dc2-5dc3 5dc6-5dc7 5dc9 5dcb 5dcd 5dcf 5dd1-5dd2 5dd4-5dd6 5dd8 5ddd-5de2 5de5-5de8 5deb 5dee 5df0-5df4 5df7 5df9 5dfd-5dff 5e02-5e04 5e06 5e0a 5e0c 5e0e 5e11 5e14-5e1b 5e1d 5e1f-5e25 5e28-5e29 5e2b 5e2d 5e33-5e34 5e36-5e38 5e3d-5e3e 5e40-5e41 5e43-5e45 5e4a-5e4f 5e53-5e55 5e57-5e59 5e5b-5e5d 5e5f-5e63 5e66-5e70 5e72-5e76 5e78-5e79 5e7b-5e7e 5e80 5e82 5e84 5e87-5e8d 5e8f 5e95-5e97 5e9a-5e9c 5ea0 5ea2-5ea8 5eaa-5eae 5eb0-5eb9 5ebe 5ec1-5ec2 5ec4-5ecc 5ece 5ed1-5ee3 5ee5-5ee9 5eec 5eee-5eef 5ef1-5ef3 5ef6-5ef7 5efa 5efe-5eff 5f01-5f02 5f04-5f05 5f07-5f08 5f0a-5f0b 5f0f 5f12-5f15 5f17-5f18 5f1a-5f1b 5f1d 5f1f 5f22-5f24 5f26-5f29 5f2d-5f2e 5f30-5f31 5f33 5f35-5f38 5f3c 5f40 5f43-5f44 5f46 5f48-5f4c 5f4e-5f4f 5f54 5f56-5f59 5f5d 5f62 5f64-5f65 5f67 5f69-5f6d 5f6f-5f71 5f73-5f74 5f76-5f79 5f7c-5f82 5f85-5f8c 5f90-5f92 5f96-5f99 5f9b-5f9c 5f9e-5fa1 5fa5-5fa6 5fa8-5faf 5fb2 5fb5-5fb7 5fb9 5fbb-5fc1 5fc3 5fc5 5fc9 5fcc-5fcd 5fcf-5fd2 5fd4-5fd9 5fdd-5fde 5fe0-5fe1 5fe3-5fe5 5fe8 5fea-5feb 5fed-5fef 5ff1 5ff3-5ff5 5ff7-5ff8 5ffa-5ffb 5ffd 5fff-6000 6009-6017 6019-601e 6020-6022 6024-602f 6032-6035 6037 6039 6040-6047 6049 604c-604d 6050 6053-6055 6058-605b 605d-605f 6062-6070 6072 607f-6081 6083-608a 608c-608e 6090 6092 6094-6097 609a-609d 609f-60a0 60a2-60a3 60a8 60b0-60b2 60b4-60c1 60c3-60cf 60d1 60d3-60d5 60d8-60dd 60df-60e2 60e4 60e6 60f0-60fc 60fe-6101 6103-6106 6108-610b 610d-6110 6112-6116 6118 611a-611d 611f 6123 6127-6129 612b-612c 612e-612f 6132 6134 6136-6137 613b 613e-6141 6144-614f 6152-6156 6158 615a-615b 615d-615f 6161-6163 6165-6168 616a-616c 616e 6170-6177 6179-617a 617c 617e 6180 6182-6183 6189-618e 6190-6194 6196 619a-619b 619d 619f 61a1-61a2 61a4 61a7-61b6 61b8 61ba 61bc 61be-61bf 61c1-61c3 61c5-61cd 61d6 61d8 61de-61e0 61e3-61eb 61ed-61ee 61f0-61f2 61f5-6201 6203-6204 6207-620a 620c-620e 6210-6212 6214-6216 6219-621b 621f-6225 6227 6229-622b 622d-622e 6230 6232-6234 6236 623a 623d-6243 6246-624b 624d-624e 6250-6254 6258-625c 625e 6260-6266 626d-6274 6276-6277 6279-6281 6283-6284 6286-628a 628c 628e-628f 6291-6298 62a8-62b1 62b3-62b6 62b8-62b9 62bb-62bf 62c2 62c4 62c6-62d4 62d6-62dc 62eb-6303 6307-6309 630b-6311 6313-6316 6328-632d 632f 6332-6334 6336 6338-633e 6340-6351 6354-635a 6365 6367-6369 636b 636d-6372 6375-6378 637a-637d 6380-6385 6387-638a 638c-6392 6394 6396-6399 639b-63a5 63a7-63b1 63bd-63be 63c0 63c2-63d0 63d2-63d3 63d5-63dd 63df-63e1 63e3-63e5 63e7-63eb 63ed-63f6 63f9 6406 6409-6410 6412-6418 641a-641c 641e-6428 642a-6430 6433-6437 6439 643d-6441 6443 644b 644d-644e 6450-6454 6458-6459 645b-6461 6465-6469 646b-6470 6472-647b 647d 647f 6482 6485 6487-648c 648f-6490 6492-6493 6495-649a 649c-64a0 64a2-64a6 64a9 64ab-64ae 64b0-64b3 64bb-64bf 64c1-64c5 64c7 64c9-64cb 64cd-64d0 64d2 64d4 64d6-64db 64e0 64e2-64e4 64e6 64e8-64e9 64eb-64ed 64ef-64f4 64f7-64f8 64fa-6501 6503-6504 6506-6507 6509 650c-6510 6513-6519 651b-651d 6520-6526 6529-652f 6532-6533 6536-6539 653b 653d-653f 6541 6543 6545-6546 6548-654a 654f 6551 6553-6559 655c-655e 6562-6568 656a 656c 656f 6572-657c 657f-6584 6587 658c 6590-6592 6594-6597 6599 659b-65a2 65a4-65a5 65a7-65a8 65aa-65ac 65ae-65b0 65b2-65b3 65b6-65b9 65bb-65bd 65bf 65c1-65c6 65cb-65d0 65d2-65d3 65d6-65d7 65da-65db 65dd-65df 65e1-65e2 65e5-65e6 65e8-65e9 65ec-65f5 65fa-65fd 6600 6602-6615 661c-661d 661f-6622 6624-6628 662b 662d-662f 6631-6636 6639-663a 6641-6643 6645 6647 6649-664a 664c 664f 6651-6652 6659-665f 6661-6662 6664-6666 6668 666a 666c 666e-6672 6674 6676-667c 667e 6680 6684 6686-668d 6690-6691 6694-6699 669d 669f-66a2 66a8-66ab 66ae-66b2 66b4-66b5 66b7-66bb 66bd-66be 66c0 66c4 66c6-66cc 66cf 66d2 66d6 66d8-66de 66e0 66e3-66e4 66e6 66e8-66e9 66eb-66ee 66f0 66f2-66f4 66f6-66f9 66fc 66fe-6701 6703-6705 6708-670b 670d 670f-6710 6712-6715 6717-6718 671b 671d 671f-6723 6726-6728 672a-672e 6731 6733-6735 6738-673f 6745-6749 674b-6751 6753 6755-6757 6759-675a 675c-6760 676a 676c-676d 676f-677f 6781 6783-6787 6789 678b-678e 6790-6795 6797-679a 679c-679d 679f 67ae-67b0 67b2-67bb 67c0-67c6 67c8-67d4 67d8-67df 67e2-67e7 67e9-67f8 67fa 67fc 67ff 6812-6814 6816-6818 681a 681c-681d 681f-6821 6825-6826 6828-682b 682d-682f 6831-6835 6838-683d 6840-6846 6848-6849 684b-6851 6853-6854 686b 686d-686f 6871-6872 6874-6879 687b-6883 6885-6887 6889-688c 688f-6894 6896-6897 689b-689d 689f-68a4 68a7-68b5 68c4 68c6-68c9 68cb-68ce 68d0-68d8 68da 68dc-68e1 68e3-68e4 68e6-68ec 68ee-68fd 6904-6908 690a-6915 6917 6925 692a 692f-6930 6932-6935 6937-6939 693b-693d 693f-6942 6944-6945 6948-694c 694e-694f 6951-6954 6956-6960 6962-6963 6965-6966 6968-6971 6974-697b 6982-6983 6986 698d-698e 6990-6991 6993-6997 6999-699c 699e 69a0-69a1 69a3-69b1 69b3-69b7 69b9 69bb-69bf 69c1-69c4 69c6 69c9-69d0 69d3-69d4 69d9 69e2 69e4-69e8 69eb-69ee 69f1-69f4 69f6-69f8 69fb-6a02 6a04-6a0a 6a0d 6a0f 6a11 6a13-6a19 6a1b 6a1d-6a21 6a23 6a25-6a28 6a32 6a34-6a35 6a38-6a41 6a44 6a46-6a49 6a4b 6a4d-6a51 6a54-6a56 6a58-6a5b 6a5d-6a62 6a64 6a66-6a6b 6a6d 6a6f 6a76 6a7e-6a81 6a83-6a85 6a87 6a89 6a8c-6a8e 6a90-6a97 6a9a-6a9c 6a9e-6aa6 6aa8 6aac-6aaf 6ab3-6ab4 6ab6-6abb 6abd 6ac2-6ac3 6ac5-6ac7 6acb-6acd 6acf-6ad1 6ad3 6ad9-6ae1 6ae5 6ae7-6ae8 6aea-6aec 6aee-6af1 6af3 6af8-6afc 6b00 6b02-6b04 6b08-6b0b 6b0f-6b13 6b16-6b1a 6b1e 6b20-6b21 6b23 6b25 6b28 6b2c-6b2d 6b2f 6b31-6b34 6b36-6b3f 6b41-6b43 6b45-6b4e 6b50-6b51 6b54-6b56 6b59 6b5b-6b5c 6b5e-6b67 6b6a 6b6d 6b72 6b76-6b79 6b7b 6b7e-6b80 6b82-6b84 6b86 6b88-6b8a 6b8c-6b8f 6b91 6b94-6b99 6b9b 6b9e-6ba0 6ba2-6ba7 6baa-6bab 6bad-6bb0 6bb2-6bb3 6bb5-6bb7 6bba 6bbc-6bbd 6bbf-6bc0 6bc3-6bcd 6bcf-6bd0 6bd2-6bd4 6bd6-6bd8 6bda-6bdb 6bde 6be0 6be2-6be4 6be6-6be8 6beb-6bec 6bef-6bf0 6bf2-6bf3 6bf7-6bf9 6bfb-6c06 6c08-6c09 6c0b-6c0d 6c0f-6c11 6c13-6c16 6c18-6c1b 6c1d 6c1f-6c21 6c23-6c28 6c2a-6c2c 6c2e-6c30 6c33-6c34 6c36 6c38 6c3b 6c3e-6c43 6c46 6c4a-6c50 6c52 6c54-6c55 6c57 6c59 6c5b-6c61 6c65-6c6b 6c6d 6c6f-6c74 6c76 6c78 6c7a-6c7b 6c7d-6c7e 6c80-6c90 6c92-6c96 6c98-6c9d 6cab-6cae 6cb0-6cb1 6cb3-6cb4 6cb6-6cc7 6cc9-6cca 6ccc-6ccd 6ccf-6cd7 6cd9-6cde 6ce0-6ce3 6ce5 6ce7-6ce9 6ceb-6cf3 6cf5 6cf9 6d00-6d01 6d03-6d04 6d07-6d12 6d16-6d1b 6d1d-6d20 6d22 6d25 6d27-6d42 6d58-6d5a 6d5e-6d6a 6d6c-6d70 6d74-6d80 6d82-6d8e 6d90-6d95 6d97-6d98 6daa-6dac 6dae-6daf 6db2-6db5 6db7-6db8 6dba-6dc0 6dc2 6dc4-6dcd 6dcf-6de6 6de8-6df7 6df9-6dfd 6e00 6e03 6e05 6e19-6e1d 6e1f-6e28 6e2b-6e36 6e38-6e41 6e43-6e47 6e49-6e4b 6e4d-6e4e 6e51-6e56 6e58 6e5a-6e69 6e6b 6e6e-6e6f 6e71-6e74 6e77-6e79 6e88-6e89 6e8d-6e90 6e92-6e94 6e96-6e99 6e9b-6ea7 6eaa-6eab 6eae-6eb4 6eb6-6eb7 6eb9-6eba 6ebc-6ed6 6ed8 6edc 6eeb-6eef 6ef1-6ef2 6ef4-6ef9 6efb-6f03 6f05-6f0a 6f0d-6f0f 6f12-6f15 6f18-6f1a 6f1c 6f1e-6f23 6f25-6f27 6f29-6f33 6f35-6f3c 6f3e-6f41 6f43 6f4e-6f55 6f57-6f58 6f5a-6f5b 6f5d-6f64 6f66-6f67 6f69-6f70 6f72-6f73 6f76-6f78 6f7a-6f80 6f82 6f84-6f89 6f8b-6f8e 6f90 6f92-6f97 6f9e 6fa0-6fb4 6fb6 6fb8-6fba 6fbc-6fbd 6fbf-6fc4 6fc6-6fcf 6fd4-6fd5 6fd8 6fdb-6fe4 6fe6-6fe9 6feb-6ff2 6ff4 6ff7 6ffa-6ffc 6ffe-7001 7004-7007 7009-700f 7011 7014-701d 701f-7024 7026-702b 702f-7035 7037-703c 703e-7046 7048-704a 704c 7051-7052 7055-7058 705a-705b 705d-7066 7068-706b 7070-7071 7074 7076 7078 707a 707c-707d 7082-7086 708a 708e 7091-7096 7098-709a 709f 70a1 70a4 70a9 70ab-70b1 70b3-70b5 70b7-70b8 70ba 70be 70c5-70c8 70ca-70cb 70cd-70cf 70d1-70d4 70d7-70da 70dc-70de 70e0-70e2 70e4 70ef-70f0 70f3-70f4 70f6-70fd 70ff-7100 7102 7104 7106 7109-710e 7110 7113 7117 7119-711c 711e-7123 7125-7126 7128 712e-7132 7136 713a 7141-7144 7146-7147 7149 714b-714e 7150 7152-7154 7156 7158-715a 715c-716a 716c 716e 7170 7172 7178 717b 717d 7180-7182 7184-7187 7189-718a 718f-7190 7192 7194 7197 7199-71a1 71a4-71a5 71a7-71aa 71ac 71af-71b3 71b5 71b8-71b9 71bc-71cb 71ce-71d0 71d2 71d4-71d6 71d8-71dc 71df-71e2 71e4-71e8 71ec-71ee 71f0-71f2 71f4 71f8-71f9 71fb-71ff 7201-7203 7205-7207 720a 720c-720d 7210 7213-7214 7219-721b 721d-721f 7222-7223 7226-722a 722c-722d 7230 7235-7236 7238-723b 723d-723f 7241-7242 7244 7246-724c 724f 7252-7253 7256 7258-725b 725d-7263 7267 7269-726a 726c 726e-7270 7272-7274 7276-7279 727b-7281 7284-7286 7288-7289 728b-728e 7290-7293 7295-7298 729a-729b 729d-729e 72a1-72aa 72ac 72ae-72b0 72b4-72b5 72ba 72bd 72bf-72c6 72c9-72cc 72ce 72d0-72d2 72d4 72d6-72da 72dc 72df-72e1 72e3-72e4 72e6 72e8-72eb 72f3-72f4 72f6-7301 7307-7308 730a-730c 730f 7311-7313 7316-7319 731b-731e 7322-7323 7325-7327 7329 732d 7330-7337 733a-733c 733e-7340 7342-7345 7349-734a 734c-734e 7350-7352 7357-735b 735d-7362 7365-736c 736e-7370 7372-7373 7375-7378 737a-738b 738e 7392-7397 739d 739f-73a2 73a4-73a6 73a8-73a9 73ab-73ad 73b2-73b9 73bb-73bc 73be-73c0 73c2-73c3 73c5-73c8 73ca-73cd 73d2-73d4 73d6-73de 73e0 73e3 73e5 73e7-73eb 73ed-73ee 73f4-73f6 73f8 73fa 73fc-7401 7403-740d 7416 741a-741b 741d 7420-7426 7428-7436 743a 743f-7442 7444 7446 744a-744b 744d-7452 7454-7455 7457 7459-745c 745e-745f 7462-7464 7467 7469-746a 746d-7473 7475 7479 747c-7481 7483 7485-748b 7490 7492 7494-7495 7497-7498 749a 749c 749e-74a1 74a3 74a5-74ab 74ad 74af-74b2 74b5-74b8 74ba-74bb 74bd-74c3 74c5 74ca-74cb 74cf 74d4-74e6 74e8-74e9 74ec 74ee 74f4-74f7 74fb 74fd-7500 7502-7504 7507-7508 750b-750d 750f-7518 751a 751c-751d 751f 7521-7522 7525-7526 7528-7533 7537-753a 753d-7540 7547-7548 754b-754c 754e-754f 7554 7559-755d 755f 7562-7566 756a-756c 756f-7570 7576-7579 757d-7580 7584 7586-7587 758a-758c 758f-7591 7594-7595 7598-759a 759d 75a2-75a5 75a7 75aa-75ab 75b0 75b2-75b3 75b5-75b6 75b8-75c2 75c4-75c5 75c7 75ca-75d2 75d4-75d5 75d7-75db 75dd-75e4 75e6-75e7 75ed 75ef-7601 7603 7608-760d 760f-7611 7613-7616 7619-7629 762d 762f-7635 7638 763a 763c-763d 7642-7643 7646-7649 764c 7650 7652-7653 7656-765a 765c 765f-7662 7664-7665 7669-766a 766c-766e 7670-7672 7675 7678-7679 767b-767f 7681-7682 7684 7686-768b 768e-768f 7692-7693 7695-7696 7699-769e 76a4 76a6 76aa-76ab 76ad-76b0 76b4-76b5 76b8 76ba-76bb 76bd-76bf 76c2-76c6 76c8-76ca 76cd-76ce 76d2-76d4 76da-76df 76e1 76e3-76e7 76e9-76ea 76ec-76f5 76f7-76fc 76fe 7701 7703-7705 7707-770b 7710-7713 7715 7719-771b 771d 771f-7720 7722-7723 7725 7727-7729 772d 772f 7731-773e 7744-7747 774a-774f 7752 7754-7756 7759-775c 775e-7763 7765-776f 7779 777c-7785 7787-7789 778b-778f 7791 7795 7797 7799-77a3 77a5 77a7-77a8 77aa-77ad 77b0-77b7 77ba-77bd 77bf 77c2 77c4 77c7 77c9-77ca 77cc-77d0 77d3-77d5 77d7-77dc 77de 77e0 77e2-77e3 77e5 77e7-77e9 77ec-77f3 77f7-77fd 7802-7803 7805-7806 7809 780c-7814 781d 781f-7823 7825-7835 7837-7838 7843 7845 7848-784a 784c-784e 7850 7852 785c-785e 7860 7862 7864-7865 7868-7871 7879 787b-787c 787e-7880 7883-7887 7889 788c 788e-788f 7891 7893-789a 789e-78a5 78a7-78ad 78b0 78b2-78b4 78ba-78bc 78be 78c1 78c3-78c5 78c8-78d1 78d4-78d5 78da-78db 78dd-78e3 78e5 78e7-78ea 78ec-78ed 78ef 78f2-78f4 78f7 78f9-78ff 7901-7902 7904-7905 7909 790c 790e 7910-7914 7917 7919 791b-791e 7921 7923-792d 792f 7931 7935 7938-793a 793d-7942 7944-794c 794f-7957 795a-7961 7963-7965 7967-796b 796d 7970 7972-7974 7979-797a 797c-797d 797f 7981-7982 7988 798a-798b 798d-7990 7992-7998 799a-799c 79a0-79a2 79a4 79a6-79a8 79aa-79ae 79b0-79b4 79b6-79bb 79bd-79c1 79c5 79c8-79c9 79cb 79cd-79cf 79d1-79d2 79d5-79d6 79d8 79dc-79e0 79e3-79e4 79e6-79e7 79e9-79ee 79f6-79f8 79fa-79fb 7a00 7a02-7a05 7a08 7a0a-7a0d 7a10-7a15 7a17-7a1c 7a1e-7a20 7a22 7a26 7a28 7a2b 7a2e-7a31 7a37 7a39 7a3b-7a3d 7a3f-7a40 7a44 7a46-7a48 7a4a-7a4e 7a54 7a56-7a58 7a5a-7a5c 7a5f-7a62 7a67-7a69 7a6b-7a6e 7a70-7a71 7a74-7a76 7a78-7a7b 7a7e-7a81 7a84-7a8c 7a8f-7a90 7a92 7a94-7a99 7a9e-7aa0 7aa2-7aa3 7aa8-7aac 7aae-7aaf 7ab1-7ab8 7aba 7abe-7ac1 7ac4-7ac5 7ac7 7aca-7acb 7ad1 7ad8-7ad9 7adf-7ae0 7ae3-7ae6 7aeb 7aed-7aef 7af6-7af7 7af9-7afb 7afd 7aff-7b01 7b04-7b06 7b08-7b0a 7b0e-7b13 7b18-7b1b 7b1d-7b1e 7b20 7b22-7b26 7b28 7b2a-7b35 7b38 7b3b 7b40 7b44-7b52 7b54 7b56 7b58 7b60-7b61 7b63-7b67 7b69 7b6d-7b6e 7b70-7b78 7b82 7b84-7b85 7b87-7b88 7b8a-7b91 7b94-7b99 7b9b-7b9d 7ba0-7ba1 7ba4 7bac-7bad 7baf 7bb1 7bb4-7bb5 7bb7-7bb9 7bbe 7bc0-7bc1 7bc4 7bc6-7bc7 7bc9-7bcc 7bce 7bd4-7bd5 7bd8-7beb 7bf0-7bf4 7bf7-7bf9 7bfb 7bfd-7c03 7c05-7c07 7c09-7c11 7c19 7c1c-7c23 7c25-7c2d 7c30 7c33 7c37-7c39 7c3b-7c40 7c43 7c45 7c47-7c4a 7c4c-7c4d 7c50 7c53-7c54 7c57 7c59-7c5c 7c5f-7c60 7c63-7c67 7c69-7c6c 7c6e-7c6f 7c72-7c73 7c75 7c78-7c7a 7c7d 7c7f-7c81 7c84-7c85 7c88-7c8a 7c8c-7c8d 7c91-7c92 7c94-7c98 7c9e-7c9f 7ca1-7ca3 7ca5 7ca8 7caf 7cb1-7cb5 7cb9-7cbf 7cc5 7cc8 7cca-7ccc 7cce 7cd0-7cd2 7cd4-7cd7 7cd9 7cdc-7ce0 7ce2 7ce7-7ce8 7cea 7cec 7cee-7cf2 7cf4 7cf6-7cf8 7cfb 7cfd-7cfe 7d00-7d22 7d28-7d29 7d2b-7d2c 7d2e-7d33 7d35-7d36 7d38-7d47 7d4a 7d4e-7d56 7d58 7d5b-7d5c 7d5e-7d5f 7d61-7d63 7d66-7d6b 7d6d-7d73 7d79-7d7d 7d7f-7d81 7d83-7d86 7d88 7d8c-7d8f 7d91-7d94 7d96 7d9c-7da3 7da6-7da7 7da9-7daa 7dac-7db2 7db4-7db5 7db7-7dc2 7dc4-7dc7 7dc9-7dcc 7dce 7dd2 7dd7-7ddb 7ddd-7de1 7de3 7de6-7dea 7dec 7dee-7df4 7df6-7df7 7df9-7dfb 7e03 7e08-7e17 7e1a-7e25 7e29-7e2b 7e2d-7e49 7e4c 7e50-7e5a 7e5c 7e5e-7e63 7e68-7e6b 7e6d 7e6f-7e70 7e72-7e7e 7e80-7e82 7e86-7e88 7e8a-7e8d 7e8f 7e91 7e93-7e9c 7f36 7f38-7f3a 7f3d-7f3f 7f43-7f45 7f48 7f4a-7f4d 7f4f-7f51 7f54-7f55 7f58 7f5b-7f61 7f63 7f65-7f6e 7f70 7f72-7f73 7f75-7f77 7f79-7f7f 7f83 7f85-7f8e 7f91-7f92 7f94-7f96 7f9a-7f9e 7fa0-7fa2 7fa4-7fa9 7fac-7fad 7faf-7fb3 7fb5-7fc3 7fc5 7fc7 7fc9-7fd2 7fd4-7fd5 7fd7 7fdb-7fdc 7fde-7fe3 7fe5-7fe6 7fe8-7ff5 7ff7-7ff9 7ffb-8001 8003-8007 800b-8012 8014-8019 801b-801c 801e-801f 8021 8024 8026 8028-802a 802c 8030 8033-8037 8039 803d-803f 8043 8046-8048 804a 804f-8052 8056 8058 805a 805c-805e 8064 8067 806c 806f-8073 8075-8079 807d-807f 8082 8084-8087 8089-808c 808f-8090 8092-8093 8095-8096 8098-809d 80a1-80a3 80a5 80a9-80ab 80ad-80af 80b1-80b2 80b4-80b5 80b8 80ba 80c2-80c5 80c7-80ca 80cc-80d1 80d4-80de 80e0-80e1 80e3-80e6 80ed 80ef-80f5 80f8-80fe 8100-8102 8105-8106 8108 810a 8115-8116 8118-8119 811b 811d-811f 8121-8125 8127 8129 812b-812d 812f-8130 8139-813a 813d-813e 8143-8144 8146-8147 814a-8155 815b-815c 815e 8160-8162 8164-8167 8169 816b 816e-8174 8176-817a 817f-8180 8182-8183 8186-818d 818f 8195 8197-81a0 81a2-81a3 81a6-81a9 81ab-81ac 81ae 81b0-81b5 81b7 81b9-81c0 81c2-81c7 81c9-81ca 81cc-81cd 81cf-81d2 81d5 81d7-81db 81dd-81e3 81e5-81ea 81ec-81ee 81f2-81f4 81f7-81fc 81fe-8202 8204-8205 8207-820d 8210-8212 8214-8216 821b-8222 8225 8228 822a-822c 822f 8232-823a 823c-823d 823f-8240 8242 8244-8245 8247 8249 824b 824e-8253 8255-825c 825e-825f 8261 8263-8264 8266 8268-8269 826b-826f 8271-8272 8274-8275 8277-8278 827c-8280 8283-8285 828a-828b 828d-8294 8298-829b 829d-82a5 82a7-82a9 82ab-82b1 82b3-82be 82c0 82c2-82c3 82d1-82d7 82d9 82db-82dc 82de-82e1 82e3-82e8 82ea-82ed 82ef-82f6 82f9-82fb 82fe 8300-8309 830c-830d 8316-8317 8319 831b-831c 831e 8320 8322 8324-832d 832f 8331-833c 833f-8345 8347-8354 8356 8373-8378 837a-837f 8381 8383 8386-8390 8392-839b 839d-839e 83a0 83a2-83ab 83ae-83b0 83bd 83bf-83cc 83ce-83cf 83d1 83d4-83d9 83db-83e5 83e7-83ec 83ee-83f6 83f8-83ff 8401 8403-8404 8406-8407 8409-8413 841b 8423 8429 842b-842d 842f-843d 843f-8440 8442-8447 8449 844b-844e 8450-8452 8454 8456-8457 8459-845b 845d-8461 8463 8465-8469 846b-8470 8473-847a 847d-847e 8482 8486 848d-8491 8494 8497-84a2 84a4 84a7-84ac 84ae-84b2 84b4 84b6 84b8-84bc 84bf-84c2 84c4-84c7 84c9-84d4 84d6-84d7 84db 84e7-84ec 84ee-84f4 84f6-84f7 84f9-8500 8502 8506-850f 8511-851a 851c-8521 8523-8531 853b 853d-853e 8540-8541 8543-854a 854d-854e 8551 8553-8559 855b 855d-855e 8560-856e 8571 8575-857c 857e 8580-8591 8594-8596 8598-85a4 85a6-85aa 85af-85b1 85b3-85ba 85bd-85c0 85c2-85c9 85cb 85cd-85d2 85d5 85d7-85da 85dc-85df 85e1-85e6 85e8-85ed 85ef-85f2 85f6-85fb 85fd-8601 8604-8607 8609-860c 8611 8617-861c 861e-8627 8629-862a 862c-862e 8631-8636 8638-863c 863e-8640 8643 8646-8648 864b-864e 8650 8652-8656 8659 865b-865c 865e-865f 8661-8665 8667-866b 866d-8671 8673-8674 8677 8679-867c 8685-8687 868a-868e 8690-8691 8693-869a 869c-869e 86a1-86a5 86a7-86aa 86af-86b1 86b3-86c9 86cb-86cc 86d0-86d1 86d3-86d4 86d6-86df 86e2-86e4 86e6 86e8-86ed 86f5-86fb 86fe 8700-870e 8711-8713 8718-871c 871e 8720-872a 872c-872e 8730-8735 8737-8738 873a-873c 873e-8743 8746 874c-876f 8773-877b 8781-8785 8787-8789 878d 878f-8794 8796-8798 879a-879f 87a2-87a4 87aa-87b0 87b2-87c0 87c2-87c6 87c8-87cc 87d1-87d4 87d7-87d9 87db-87e8 87ea-87ed 87ef 87f2-87f4 87f6-87f7 87f9-87fc 87fe-8803 8805-8806 8808-880d 8810-8811 8813-8817 8819 881b-881d 881f-8826 8828-882c 882e-8833 8835-8839 883b-8841 8843-8844 8848 884a-884e 8852-8853 8855-8857 8859-885b 885d 8861-8863 8867-886b 886d 886f-8872 8874-8877 8879 887c-8883 8888-8889 888b-888e 8891-8893 8895-889b 889e-889f 88a1-88a2 88a4 88a7-88a8 88aa-88ac 88b1-88b2 88b6-88ba 88bc-88be 88c0-88c2 88c9-88ce 88d0 88d2 88d4-88df 88e1 88e7-88e8 88eb-88ec 88ee-88f4 88f6-88fe 8901-8902 8905-8907 8909-890c 890e 8910-891a 891e-891f 8921-8923 8925-8927 8929-8933 8935-8938 893b-893e 8941-8942 8944 8946 8949 894b-894c 894f-8953 8956-8964 8966 8969-896f 8971-8974 8976 8979-897c 897e-897f 8981-8983 8985-8986 8988 898b 898f 8993 8995-8998 899b-899f 89a1-89a4 89a6 89aa 89ac-89af 89b2 89b6-89b7 89b9-89ba 89bd-89c0 89d2-89d6 89d9-89dd 89df-89e6 89e8-89e9 89eb-89ed 89f0-89f4 89f6-89f8 89fa-89fc 89fe-8a00 8a02-8a04 8a07-8a08 8a0a 8a0c 8a0e-8a13 8a15-8a18 8a1b 8a1d-8a1f 8a22-8a23 8a25 8a27 8a2a 8a2c-8a2d 8a30-8a31 8a34 8a36 8a39-8a3c 8a3e-8a41 8a44-8a46 8a48 8a4a 8a4c-8a52 8a54-8a59 8a5b 8a5e 8a60-8a63 8a66 8a68-8a69 8a6b-8a6e 8a70-8a77 8a79-8a7c 8a7f 8a81-8a87 8a8b-8a8d 8a8f 8a91-8a93 8a95-8a96 8a98-8a9a 8a9e 8aa0-8aa1 8aa3-8aa8 8aaa-8aab 8ab0 8ab2 8ab6 8ab8-8ac0 8ac2-8ac9 8acb 8acd 8acf 8ad1-8ad9 8adb-8ae2 8ae4 8ae6-8ae8 8aeb 8aed-8af8 8afa-8afc 8afe-8b02 8b04-8b08 8b0a-8b0b 8b0d-8b1e 8b20 8b22-8b28 8b2a-8b2c 8b2e-8b31 8b33 8b35-8b37 8b39-8b3e 8b40-8b42 8b45-8b4b 8b4e-8b5a 8b5c-8b5d 8b5f-8b60 8b63 8b65-8b68 8b6a-8b6d 8b6f-8b70 8b74 8b77-8b7b 8b7d-8b80 8b82 8b84-8b86 8b88 8b8a-8b8c 8b8e 8b92-8b96 8b98-8b9a 8b9c 8b9e-8b9f 8c37 8c39 8c3b-8c3f 8c41-8c43 8c45-8c50 8c54-8c57 8c5a 8c5c-8c5d 8c5f 8c61-8c62 8c64-8c66 8c68-8c6d 8c6f-8c73 8c75-8c7b 8c7d 8c80-8c82 8c84-8c86 8c89-8c8a 8c8c-8c8d 8c8f-8c95 8c97-8c9a 8c9c-8c9e 8ca0-8ca5 8ca7-8cac 8caf-8cb0 8cb2-8cc5 8cc7-8cc8 8cca 8ccc 8ccf 8cd1-8cd3 8cd5 8cd7 8cd9-8cda 8cdc-8ce8 8cea 8cec-8cee 8cf0-8cf1 8cf3-8cf5 8cf8-8cfe 8d00 8d02 8d04-8d0a 8d0d 8d0f-8d10 8d13-8d17 8d19 8d1b 8d64 8d66-8d69 8d6b-8d70 8d72-8d74 8d76-8d79 8d7b 8d7d 8d80-8d81 8d84-8d85 8d89-8d8a 8d8c-8d96 8d99 8d9b-8d9c 8d9f-8da1 8da3 8da5 8da7-8da8 8daa-8daf 8db2-8db7 8db9-8dba 8dbc 8dbe-8dbf 8dc1-8dc2 8dc5-8dc8 8dcb-8dd1 8dd3 8dd5-8ddd 8ddf-8de4 8de6-8dec 8dee-8df4 8dfa 8dfc-8e00 8e02-8e07 8e09-8e0a 8e0d 8e0f-8e27 8e29 8e2b 8e2e 8e30-8e31 8e33-8e36 8e38-8e39 8e3c-8e42 8e44-8e45 8e47-8e4e 8e50 8e53-8e57 8e59-8e67 8e69-8e6a 8e6c-8e6d 8e6f 8e72-8e74 8e76 8e78 8e7a-8e7c 8e81-8e82 8e84-8e8e 8e90-8e98 8e9a 8e9d-8ea1 8ea3-8ea6 8ea8-8eac 8eb2 8eba 8ebd 8ec0 8ec2 8ec9-8ecd 8ecf 8ed1-8ed4 8ed7-8ed8 8edb-8ee1 8ee5-8ee9 8eeb-8eec 8eee-8eef 8ef1 8ef4-8efc 8efe-8f03 8f05-8f0b 8f0d-8f0e 8f10-8f18 8f1a-8f20 8f23-8f26 8f29-8f2a 8f2c 8f2e-8f2f 8f32-8f39 8f3b 8f3e-8f40 8f42-8f49 8f4b 8f4d-8f5b 8f5d-8f64 8f9b-8f9c 8f9f 8fa3 8fa6 8fa8 8fad-8fb2 8fb4 8fbf 8fc2 8fc4-8fc6 8fc9 8fcb 8fcd-8fce 8fd1-8fd7 8fe0-8fe6 8fe8 8fea-8feb 8fed-8fee 8ff0 8ff4-8ff8 8ffa-9006 900b-900d 900f-9011 9014-9017 9019-9024 902d-902f 9031-9032 9034-9036 9038 903c-903f 9041-9042 9044 9047 9049-904b 904d-9055 9058-9059 905b-905e 9060 9062-9063 9067-9069 906b 906d-9070 9072-9088 908a-908b 908d 908f-9091 9094-9095 9097-9099 909b 909e-90a3 90a5-90a7 90aa 90af-90b6 90b8 90bd-90bf 90c1 90c3 90c5 90c7-90c8 90ca-90cb 90ce 90d4-90dd 90df-90e5 90e8-90ed 90ef-90f5 90f9-9109 910b 910d-9112 9114 9116-9124 9126-9136 9138-913b 913e-9141 9143-9150 9152-9153 9155-9158 915a 915f-9165 9168-916a 916c 916e-916f 9172-9175 9177-917a 9180-9187 9189-918b 918d 918f-9193 9199-91a3 91a5 91a7-91a8 91aa-91b5 91b7 91b9-91ba 91bc-91be 91c0-91c3 91c5-91c7 91c9 91cb-91d1 91d3-91d5 91d7-91da 91dc-91dd 91e2-91e4 91e6-91ee 91f1 91f3-91f5 91f7-91f9 91fd 91ff-9207 9209-920a 920c-920d 920f-9212 9214-9217 9219-921a 921c 921e 9223-9227 922d-922e 9230-9234 9236-923a 923d-9240 9245-9246 9248-9254 9256-9257 925a-925b 925e 9260-9261 9263-9267 926c-926d 926f-9270 9272 9276 9278-9280 9282-9283 9285-9288 928a-928e 9291 9293-929d 92a0-92ac 92b2-92b7 92bb-92bc 92c0-92d3 92d5 92d7-92d9 92dd-92e1 92e4 92e6-92ea 92ee-92f1 92f7-92fc 92fe-9302 9304 9306 9308-9309 930b-9310 9312-9316 9318-931b 931d-932b 932d-932f 9333-9336 9338-9339 933c 9346-9347 9349-9352 9354-935c 935e 9360-9361 9363-9365 9367 936a 936c-936d 9370-9371 9375-9377 9379-937c 937e 9380 9382-9383 9388-938a 938c-938f 9391-9392 9394-939b 939d-939f 93a1-93aa 93ac 93ae-93b5 93b7 93c0 93c2-93c4 93c7-93c8 93ca 93cc-93d2 93d4-93da 93dc-93df 93e1-93e4 93e6-93e8 93ec 93ee 93f5-9400 9403 9406-9407 9409-9416 9418-9419 9420 9428-942c 942e 9430-9433 9435-943d 943f-9440 9444-944c 944f-9452 9455 9457 945d-945e 9460 9462-9464 9468-946b 946d-9478 947c-9483 9577 957a-957d 9580 9582-9583 9586 9588-9589 958b-9594 9598 959b-959c 959e-959f 95a1 95a3-95a5 95a8-95a9 95ab-95ae 95b0-95b1 95b5-95b7 95b9-95c0 95c3 95c5-95cd 95d0-95d6 95da-95dc 95de-95e5 961c 961e 9620-9624 9628 962a 962c-9632 9639-963d 963f-9640 9642-9644 964a-9651 9653-9654 9658 965b-965f 9661-9664 966a-966d 966f-9678 967c-967e 9680 9683-968b 968d-968e 9691-9695 9697-9699 969b-969c 969e 96a1-96a2 96a4 96a7-96aa 96ac 96ae 96b0-96b1 96b3-96b4 96b8-96b9 96bb-96bc 96bf-96ce 96d2-96df 96e1-96e3 96e5 96e8-96ea 96ef-96f2 96f5-96fb 96fd 96ff-9700 9702 9704-9709 970b 970d-9713 9716 9718-9719 971c-9720 9722-972c 972e-9730 9732 9735 9738-973a 973d-973f 9742-9744 9746-9749 974b 9752 9756 9758 975a-975c 975e 9760-9762 9766 9768-976a 976c 976e 9770 9772-9774 9776-9778 977a-9785 9788 978a-978b 978d-978f 9794 9797-979a 979c-979e 97a0-97a6 97a8 97aa-97ae 97b3 97b6-97b7 97b9 97bb 97bf 97c1 97c3-97c7 97c9 97cb-97d0 97d3-97d9 97dc-97df 97e1 97e3 97e5 97ed 97f0-97f1 97f3 97f6 97f8-97fb 97fd-9808 980a 980c-9813 9816-9818 981b-981e 9820-9821 9824 9826-9829 982b 982d 982f-9830 9832 9835 9837-9839 983b 9841 9843-9846 9848-984a 984c-9853 9857-9859 985b-9860 9862-9865 9867 9869-986b 986f-9874 98a8-98a9 98ac-98af 98b1-98b3 98b6 98b8 98ba-98c2 98c4 98c6 98c9 98cb-98cc 98db 98df 98e2-98e3 98e5 98e7 98e9-98eb 98ed 98ef 98f2 98f4 98f6 98f9-98fa 98fc-98fe 9900 9902-9903 9905 9907-990a 990c 9910-9918 991a-991b 991e-991f 9921 9924-9925 9927-9933 9935 993a 993c-993f 9941 9943 9945 9947-9949 994b-994c 994e 9950-9959 995b-995c 995e-995f 9961 9996-9999 999c-999e 99a1 99a3 99a5-99a8 99ab-99b5 99b9-99bb 99bd 99c1-99c3 99c7 99c9 99cb-99d9 99db-99dd 99df 99e2-99e5 99e7 99e9-99ea 99ec-99ee 99f0-99f1 99f4 99f6-99ff 9a01-9a07 9a09-9a0b 9a0d-9a0f 9a11 9a14-9a16 9a19-9a1e 9a20 9a22-9a25 9a27 9a29-9a2e 9a30-9a32 9a34-9a3a 9a3d-9a46 9a48-9a4a 9a4c-9a50 9a52-9a57 9a59-9a5b 9a5e-9a60 9a62 9a64-9a6b 9aa8 9aab 9aad 9aaf-9ab1 9ab3-9ab4 9ab7-9ab9 9abb-9abc 9abe-9ac2 9ac6-9ac7 9aca 9acd 9acf-9ad6 9ad8 9adc 9adf 9ae1 9ae3 9ae6-9ae7 9aeb-9aef 9af1-9af3 9af6-9af7 9af9-9afe 9b01 9b03-9b06 9b08 9b0a-9b0e 9b10-9b12 9b15-9b1a 9b1e-9b20 9b22-9b25 9b27-9b29 9b2b 9b2e-9b2f 9b31-9b33 9b35 9b37 9b3a-9b3c 9b3e-9b3f 9b41-9b46 9b48 9b4a-9b4f 9b51-9b52 9b54-9b56 9b58-9b5b 9b5f-9b61 9b64 9b66-9b68 9b6c 9b6f-9b71 9b74-9b77 9b7a-9b7e 9b80 9b82 9b85-9b88 9b90-9b93 9b95 9b9a-9b9b 9b9e 9ba0-9ba2 9ba4-9ba6 9ba8 9baa-9bab 9bad-9baf 9bb5-9bb6 9bb8-9bb9 9bbd 9bbf-9bc1 9bc3-9bc4 9bc6-9bca 9bd3-9bd7 9bd9-9bdc 9bde 9be0-9be2 9be4-9be8 9bea-9bec 9bf0 9bf7-9bf8 9bfd 9c05-9c09 9c0b 9c0d-9c0e 9c12-9c14 9c17 9c1c-9c1d 9c21 9c23-9c25 9c28-9c29 9c2b-9c2d 9c31-9c34 9c36-9c37 9c39 9c3b-9c41 9c44 9c46 9c48-9c4e 9c50 9c52 9c54-9c59 9c5e-9c60 9c62-9c63 9c66-9c68 9c6d-9c6e 9c71 9c73-9c75 9c77-9c7a 9ce5-9ce7 9ce9-9cea 9ced 9cf1-9cf7 9cf9-9cfd 9cff-9d00 9d03-9d09 9d10 9d12 9d14-9d15 9d17-9d19 9d1b 9d1d-9d20 9d22-9d23 9d25-9d26 9d28-9d29 9d2d-9d31 9d33 9d36-9d38 9d3b 9d3d-9d43 9d45 9d4a-9d4c 9d4f 9d51-9d54 9d56-9d5d 9d5f-9d61 9d67-9d6c 9d6f-9d75 9d77-9d79 9d7b 9d7d 9d7f-9d82 9d84-9d8c 9d90 9d92 9d94 9d96-9da4 9da6-9daa 9dac-9dad 9daf 9db1-9dbc 9dbe-9dbf 9dc1-9dc3 9dc5 9dc7-9dc8 9dca-9dd3 9dd5-9ddf 9de1-9de6 9de8-9de9 9deb-9df0 9df2-9dfb 9dfd-9e07 9e09 9e0b 9e0d 9e0f-9e15 9e17 9e19-9e1b 9e1d-9e1e 9e75 9e79-9e7a 9e7c-9e7d 9e7f-9e80 9e82-9e83 9e86-9e8e 9e91-9e94 9e97 9e99-9e9d 9e9f-9ea1 9ea4-9ea5 9ea7 9ea9 9ead-9eae 9eb0 9eb4-9eb7 9ebb-9ebc 9ebe 9ec0 9ec2-9ec3 9ec8 9ecc-9ed1 9ed3-9ed6 9ed8 9eda-9ee0 9ee4-9ee8 9eeb 9eed-9ef0 9ef2-9ef7 9ef9-9efd 9eff-9f01 9f06-9f07 9f09-9f0a 9f0e-9f10 9f12-9f13 9f15-9f16 9f18-9f1c 9f1e 9f20 9f22-9f25 9f28-9f38 9f3b 9f3d-9f3e 9f40-9f43 9f46-9f4f 9f52 9f54-9f59 9f5b-9f61 9f63-9f67 9f6a-9f6c 9f6e-9f72 9f74-9f7b 9f7e 9f8d 9f90-9f92 9f94-9f95 9f98 9f9c 9fa0 9fa2 9fa4 fa0c-fa0d",
+	"zu":       "41-5a 61-7a",
+}
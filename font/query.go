@@ -0,0 +1,233 @@
+package font
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/marguerite/fonts-config-ng/charset"
+)
+
+// Filter the fonts of the collection keep returns true for
+func (c Collection) Filter(keep func(Font) bool) Collection {
+	newC := Collection{}
+	for _, font := range c {
+		if keep(font) {
+			newC = append(newC, font)
+		}
+	}
+	return newC
+}
+
+// normalizeLang lower case a language tag and use "-" as separator, like fontconfig does
+func normalizeLang(lang string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(lang), "_", "-", -1))
+}
+
+// LangMatches compare language tags like fontconfig: "zh-tw" needs the exact language and territory,
+// the territories only matter if both tags have one, so "zh" matches any territory and "de-at" matches "de"
+func LangMatches(a, b string) bool {
+	a, b = normalizeLang(a), normalizeLang(b)
+	if a == b {
+		return true
+	}
+	pa, pb := strings.SplitN(a, "-", 2), strings.SplitN(b, "-", 2)
+	return pa[0] == pb[0] && (len(pa) == 1 || len(pb) == 1)
+}
+
+// HasLang whether the font supports lang with fontconfig semantics, see LangMatches
+func (f Font) HasLang(lang string) bool {
+	for _, l := range f.Lang {
+		if LangMatches(lang, l) {
+			return true
+		}
+	}
+	return false
+}
+
+// ByLang the fonts supporting any of langs
+func (c Collection) ByLang(langs ...string) Collection {
+	return c.Filter(func(f Font) bool {
+		for _, lang := range langs {
+			if f.HasLang(lang) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// Covers whether the font has every character of cs
+func (f Font) Covers(cs charset.Charset) bool {
+	return covers(f.Charset, cs)
+}
+
+// ByCharset the fonts having every character of cs
+func (c Collection) ByCharset(cs charset.Charset) Collection {
+	return c.Filter(func(f Font) bool { return f.Covers(cs) })
+}
+
+// ScriptCharset the characters of the Unicode script named like "Han", "Arabic" or "Thai"
+func ScriptCharset(script string) (charset.Charset, bool) {
+	return charset.Script(script)
+}
+
+// ByScript the fonts covering at least ratio (0 to 1) of the characters of the Unicode script,
+// an unknown script matches no font
+func (c Collection) ByScript(script string, ratio float64) Collection {
+	cs, ok := ScriptCharset(script)
+	if !ok {
+		return Collection{}
+	}
+	want := cs.Bitmap()
+	total := want.Count()
+	return c.Filter(func(f Font) bool {
+		return total > 0 && float64(f.Charset.Bitmap().Intersect(want).Count()) >= ratio*float64(total)
+	})
+}
+
+// GenericFamily the generic family the font belongs to: sans-serif, serif, monospace, emoji or symbol
+func (f Font) GenericFamily() string {
+	var name string
+	if len(f.Name) > 0 {
+		name = f.Name[0]
+	}
+	switch {
+	case strings.Contains(name, " Symbols"):
+		return "symbol"
+	case strings.Contains(name, " Mono") || strings.Contains(name, " HW") || f.Spacing == 100:
+		return "monospace"
+	case strings.HasSuffix(name, "Emoji") || f.IsEmoji():
+		return "emoji"
+	case strings.Contains(name, " Serif"):
+		return "serif"
+	}
+	return "sans-serif"
+}
+
+// ByGenericFamily the fonts belonging to the generic family
+func (c Collection) ByGenericFamily(generic string) Collection {
+	return c.Filter(func(f Font) bool { return f.GenericFamily() == generic })
+}
+
+// BySpacing the fonts with any of the spacings: 0 proportional, 90 dual, 100 mono, 110 charcell
+func (c Collection) BySpacing(spacings ...int) Collection {
+	return c.Filter(func(f Font) bool {
+		for _, s := range spacings {
+			if f.Spacing == s {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// ByWeight the fonts with a fontconfig weight between min and max, inclusive
+func (c Collection) ByWeight(min, max int) Collection {
+	return c.Filter(func(f Font) bool { return f.Weight >= min && f.Weight <= max })
+}
+
+// ByFormat the fonts in the font format, eg. "TrueType" or "CFF", ignoring case
+func (c Collection) ByFormat(format string) Collection {
+	return c.Filter(func(f Font) bool { return strings.EqualFold(f.FontFormat, format) })
+}
+
+// ByColor the color fonts, or the ones without color glyphs
+func (c Collection) ByColor(color bool) Collection {
+	return c.Filter(func(f Font) bool { return f.Color == color })
+}
+
+// ByFileGlob the fonts whose file matches the shell pattern, a pattern without "/" is matched
+// against the file name only
+func (c Collection) ByFileGlob(pattern string) Collection {
+	return c.Filter(func(f Font) bool {
+		file := f.File
+		if !strings.Contains(pattern, "/") {
+			file = filepath.Base(file)
+		}
+		ok, _ := filepath.Match(pattern, file)
+		return ok
+	})
+}
+
+// familyKey compare family names like fontconfig: ignoring case and blanks
+func familyKey(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), ""))
+}
+
+// FamilyEqual whether two family names are the same for fontconfig, which ignores case and blanks
+func FamilyEqual(a, b string) bool {
+	return familyKey(a) == familyKey(b)
+}
+
+// HasFamily whether the font has any of the family names, compared with FamilyEqual
+func (f Font) HasFamily(names ...string) bool {
+	for _, n := range f.Name {
+		for _, name := range names {
+			if FamilyEqual(n, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ByFamily the fonts with any of the family names, compared with FamilyEqual
+func (c Collection) ByFamily(names ...string) Collection {
+	return c.Filter(func(f Font) bool { return f.HasFamily(names...) })
+}
+
+// GroupByFamily the fonts by their first family name
+func (c Collection) GroupByFamily() map[string]Collection {
+	m := make(map[string]Collection)
+	for _, font := range c {
+		if len(font.Name) == 0 {
+			continue
+		}
+		m[font.Name[0]] = append(m[font.Name[0]], font)
+	}
+	return m
+}
+
+// Families the first family names of the fonts, sorted and unique
+func (c Collection) Families() []string {
+	var families []string
+	for family := range c.GroupByFamily() {
+		families = append(families, family)
+	}
+	sort.Strings(families)
+	return families
+}
+
+// Dedup drop the fonts seen before: the same face of the same file, or the same style
+// and version of a family installed twice. the first one wins.
+func (c Collection) Dedup() Collection {
+	type face struct {
+		file  string
+		index int
+	}
+	type style struct {
+		family, style                 string
+		weight, width, slant, version int
+	}
+	files := make(map[face]bool)
+	styles := make(map[style]bool)
+
+	return c.Filter(func(f Font) bool {
+		fc := face{f.File, f.Index}
+		var st style
+		if len(f.Name) > 0 {
+			st.family = familyKey(f.Name[0])
+		}
+		if len(f.Style) > 0 {
+			st.style = familyKey(f.Style[0])
+		}
+		st.weight, st.width, st.slant, st.version = f.Weight, f.Width, f.Slant, f.FontVersion
+		if files[fc] || (len(st.family) > 0 && styles[st]) {
+			return false
+		}
+		files[fc] = true
+		styles[st] = true
+		return true
+	})
+}
@@ -0,0 +1,54 @@
+package font
+
+import "testing"
+
+func TestLangMatches(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"en", "en", true},
+		{"zh-TW", "zh_tw", true},
+		{"zh", "zh-tw", true},
+		{"de-at", "de", true},
+		{"zh-tw", "zh-cn", false},
+		{"en", "eo", false},
+		{"ja", "jv", false},
+	}
+	for _, tt := range tests {
+		if got := LangMatches(tt.a, tt.b); got != tt.want {
+			t.Errorf("LangMatches(%q, %q) = %t", tt.a, tt.b, got)
+		}
+		if got := LangMatches(tt.b, tt.a); got != tt.want {
+			t.Errorf("LangMatches(%q, %q) = %t", tt.b, tt.a, got)
+		}
+	}
+
+	f := Font{Lang: []string{"de", "zh-cn"}}
+	for lang, want := range map[string]bool{"de-ch": true, "zh": true, "zh-tw": false, "fr": false} {
+		if got := f.HasLang(lang); got != want {
+			t.Errorf("HasLang(%q) = %t", lang, got)
+		}
+	}
+}
+
+func TestFamilyEqual(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"DejaVu Sans", "dejavusans", true},
+		{"Noto Sans CJK SC", " noto sans  cjk sc", true},
+		{"DejaVu Sans", "DejaVu Sans Mono", false},
+	}
+	for _, tt := range tests {
+		if got := FamilyEqual(tt.a, tt.b); got != tt.want {
+			t.Errorf("FamilyEqual(%q, %q) = %t", tt.a, tt.b, got)
+		}
+	}
+
+	c := Collection{{Name: []string{"DejaVu Sans"}}, {Name: []string{"Noto Sans", "Noto Sans Regular"}}}
+	if got := c.ByFamily("notosansregular", "Liberation Sans"); len(got) != 1 || !got[0].HasFamily("Noto Sans") {
+		t.Errorf("ByFamily %v", got)
+	}
+}
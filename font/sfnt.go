@@ -0,0 +1,563 @@
+package font
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/marguerite/fonts-config-ng/charset"
+)
+
+// errNotSfnt the file is not a TrueType/OpenType font or collection
+var errNotSfnt = errors.New("not a TrueType/OpenType font")
+
+// sfnt a single face of a TrueType/OpenType font file
+type sfnt struct {
+	data   []byte
+	tables map[string][]byte
+}
+
+// sfntNames the strings of the name table we are interested in, English ones first
+type sfntNames struct {
+	family     []string
+	style      []string
+	fullname   []string
+	postscript string
+}
+
+// u16 read a big endian uint16 at offset, 0 if out of bounds
+func u16(b []byte, offset int) uint16 {
+	if offset < 0 || offset+2 > len(b) {
+		return 0
+	}
+	return binary.BigEndian.Uint16(b[offset:])
+}
+
+// u32 read a big endian uint32 at offset, 0 if out of bounds
+func u32(b []byte, offset int) uint32 {
+	if offset < 0 || offset+4 > len(b) {
+		return 0
+	}
+	return binary.BigEndian.Uint32(b[offset:])
+}
+
+// parseSfnt parse every face of a font file or font collection
+func parseSfnt(data []byte) ([]sfnt, error) {
+	if len(data) < 12 {
+		return nil, errNotSfnt
+	}
+
+	var offsets []int
+	switch string(data[:4]) {
+	case "ttcf":
+		n := int(u32(data, 8))
+		if n <= 0 || 12+4*n > len(data) {
+			return nil, fmt.Errorf("invalid font collection header")
+		}
+		for i := 0; i < n; i++ {
+			offsets = append(offsets, int(u32(data, 12+4*i)))
+		}
+	case "\x00\x01\x00\x00", "OTTO", "true":
+		offsets = []int{0}
+	default:
+		return nil, errNotSfnt
+	}
+
+	var faces []sfnt
+	for _, offset := range offsets {
+		face, err := parseTableDirectory(data, offset)
+		if err != nil {
+			return nil, err
+		}
+		faces = append(faces, face)
+	}
+	return faces, nil
+}
+
+// parseTableDirectory read the table records of the face at offset
+func parseTableDirectory(data []byte, offset int) (sfnt, error) {
+	face := sfnt{data: data, tables: make(map[string][]byte)}
+	n := int(u16(data, offset+4))
+	if offset+12+16*n > len(data) {
+		return face, fmt.Errorf("truncated table directory")
+	}
+	for i := 0; i < n; i++ {
+		rec := offset + 12 + 16*i
+		tag := string(data[rec : rec+4])
+		start := int(u32(data, rec+8))
+		length := int(u32(data, rec+12))
+		if start < 0 || length < 0 || start+length > len(data) {
+			return face, fmt.Errorf("table %s out of bounds", tag)
+		}
+		face.tables[tag] = data[start : start+length]
+	}
+	return face, nil
+}
+
+// decodeName decode a name table string according to its platform and encoding
+func decodeName(platform, encoding uint16, b []byte) (string, bool) {
+	switch {
+	case platform == 0 || (platform == 3 && (encoding == 0 || encoding == 1 || encoding == 10)):
+		u := make([]uint16, len(b)/2)
+		for i := range u {
+			u[i] = binary.BigEndian.Uint16(b[2*i:])
+		}
+		return string(utf16.Decode(u)), true
+	case platform == 1 && encoding == 0:
+		// MacRoman, its ASCII half is all that matters for family names
+		r := make([]rune, len(b))
+		for i, c := range b {
+			r[i] = rune(c)
+		}
+		return string(r), true
+	}
+	return "", false
+}
+
+// names read the names of the face: typographic family and style names before legacy ones, English first
+func (f sfnt) names() sfntNames {
+	var names sfntNames
+	t := f.tables["name"]
+	count := int(u16(t, 2))
+	storage := int(u16(t, 4))
+
+	type entry struct {
+		id      uint16
+		english bool
+		value   string
+	}
+	var entries []entry
+	for i := 0; i < count; i++ {
+		rec := 6 + 12*i
+		platform, encoding, lang, id := u16(t, rec), u16(t, rec+2), u16(t, rec+4), u16(t, rec+6)
+		length, offset := int(u16(t, rec+8)), int(u16(t, rec+10))
+		start := storage + offset
+		if start+length > len(t) {
+			continue
+		}
+		s, ok := decodeName(platform, encoding, t[start:start+length])
+		if !ok || len(strings.TrimSpace(s)) == 0 {
+			continue
+		}
+		english := (platform == 3 && lang == 0x409) || (platform == 1 && lang == 0) || platform == 0
+		entries = append(entries, entry{id, english, strings.TrimSpace(s)})
+	}
+
+	// collect the unique values of the name ids in order of preference, English values first
+	collect := func(ids ...uint16) []string {
+		var values []string
+		seen := make(map[string]bool)
+		for _, english := range []bool{true, false} {
+			for _, id := range ids {
+				for _, e := range entries {
+					if e.id == id && e.english == english && !seen[e.value] {
+						values = append(values, e.value)
+						seen[e.value] = true
+					}
+				}
+			}
+		}
+		return values
+	}
+
+	names.family = collect(16, 1)
+	names.style = collect(17, 2)
+	names.fullname = collect(4)
+	if ps := collect(6); len(ps) > 0 {
+		names.postscript = ps[0]
+	}
+	return names
+}
+
+// weightFromOpenType map an OS/2 usWeightClass to a fontconfig weight, like FcWeightFromOpenType
+func weightFromOpenType(ot int) int {
+	if ot <= 0 {
+		return 80
+	}
+	// some fonts use 1 to 9
+	if ot < 10 {
+		ot *= 100
+	}
+	if ot > 1000 {
+		ot = 1000
+	}
+	mapping := [][2]int{{100, 0}, {200, 40}, {300, 50}, {350, 55}, {380, 75}, {400, 80}, {500, 100},
+		{600, 180}, {700, 200}, {800, 205}, {900, 210}, {1000, 215}}
+	if ot <= mapping[0][0] {
+		return mapping[0][1]
+	}
+	for i := 1; i < len(mapping); i++ {
+		if ot <= mapping[i][0] {
+			lo, hi := mapping[i-1], mapping[i]
+			return lo[1] + (ot-lo[0])*(hi[1]-lo[1])/(hi[0]-lo[0])
+		}
+	}
+	return 215
+}
+
+// widthFromOpenType map an OS/2 usWidthClass to a fontconfig width
+func widthFromOpenType(ot int) int {
+	widths := []int{50, 63, 75, 87, 100, 113, 125, 150, 200}
+	if ot < 1 || ot > len(widths) {
+		return 100
+	}
+	return widths[ot-1]
+}
+
+// weight the fontconfig weight of the face
+func (f sfnt) weight() int {
+	if os2, ok := f.tables["OS/2"]; ok {
+		return weightFromOpenType(int(u16(os2, 4)))
+	}
+	if u16(f.tables["head"], 44)&1 != 0 {
+		return 200
+	}
+	return 80
+}
+
+// width the fontconfig width of the face
+func (f sfnt) width() int {
+	if os2, ok := f.tables["OS/2"]; ok {
+		return widthFromOpenType(int(u16(os2, 6)))
+	}
+	return 100
+}
+
+// slant the fontconfig slant of the face: roman 0, italic 100, oblique 110
+func (f sfnt) slant() int {
+	if os2, ok := f.tables["OS/2"]; ok {
+		sel := u16(os2, 62)
+		if sel&(1<<9) != 0 {
+			return 110
+		}
+		if sel&1 != 0 {
+			return 100
+		}
+		return 0
+	}
+	if u16(f.tables["head"], 44)&2 != 0 {
+		return 100
+	}
+	return 0
+}
+
+// spacing the fontconfig spacing of the face: proportional 0, dual 90, mono 100
+func (f sfnt) spacing() int {
+	if u32(f.tables["post"], 12) != 0 {
+		return 100
+	}
+
+	n := int(u16(f.tables["hhea"], 34))
+	hmtx := f.tables["hmtx"]
+	advances := make(map[uint16]bool)
+	for i := 0; i < n && 4*i+2 <= len(hmtx); i++ {
+		if a := u16(hmtx, 4*i); a != 0 {
+			advances[a] = true
+		}
+		if len(advances) > 2 {
+			return 0
+		}
+	}
+
+	var a []int
+	for k := range advances {
+		a = append(a, int(k))
+	}
+	sort.Ints(a)
+	switch {
+	case len(a) == 1:
+		return 100
+	case len(a) == 2 && abs(a[1]-2*a[0]) <= a[0]/20:
+		return 90
+	}
+	return 0
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
+
+// outline whether the face has outline glyphs rather than only bitmaps
+func (f sfnt) outline() bool {
+	for _, tag := range []string{"glyf", "CFF ", "CFF2"} {
+		if _, ok := f.tables[tag]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// scalable whether the face can be scaled to any size, outline and color bitmap fonts can
+func (f sfnt) scalable() bool {
+	if f.outline() {
+		return true
+	}
+	for _, tag := range []string{"CBDT", "sbix"} {
+		if _, ok := f.tables[tag]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// color whether the face has color glyphs
+func (f sfnt) color() bool {
+	for _, tag := range []string{"COLR", "CBDT", "sbix", "SVG "} {
+		if _, ok := f.tables[tag]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// variable whether the face is a variable font
+func (f sfnt) variable() bool {
+	_, ok := f.tables["fvar"]
+	return ok
+}
+
+// format the font format as reported by FreeType
+func (f sfnt) format() string {
+	for _, tag := range []string{"CFF ", "CFF2"} {
+		if _, ok := f.tables[tag]; ok {
+			return "CFF"
+		}
+	}
+	return "TrueType"
+}
+
+// version the font revision of the head table, a 16.16 fixed point number
+func (f sfnt) version() int {
+	return int(u32(f.tables["head"], 4))
+}
+
+// foundry the vendor id of the OS/2 table
+func (f sfnt) foundry() string {
+	os2 := f.tables["OS/2"]
+	if len(os2) < 62 {
+		return "unknown"
+	}
+	vendor := strings.Trim(string(os2[58:62]), " \x00")
+	if len(vendor) == 0 {
+		return "unknown"
+	}
+	return vendor
+}
+
+// exclusiveLang the CJK language the code page ranges of the OS/2 table advertise, empty unless there is exactly one
+func (f sfnt) exclusiveLang() string {
+	os2 := f.tables["OS/2"]
+	if v := u16(os2, 0); v < 1 || v == 0xffff || len(os2) < 82 {
+		return ""
+	}
+	pages := u32(os2, 78)
+	lang := ""
+	for bit := uint(17); bit <= 20; bit++ {
+		if pages&(1<<bit) == 0 {
+			continue
+		}
+		if len(lang) > 0 {
+			return ""
+		}
+		lang = exclusiveLangs[bit]
+	}
+	return lang
+}
+
+// pixelSizes the sizes of the bitmap strikes of a face without outlines
+func (f sfnt) pixelSizes() []float64 {
+	if f.outline() {
+		return nil
+	}
+	var sizes []float64
+	for _, tag := range []string{"EBLC", "CBLC", "bloc"} {
+		t, ok := f.tables[tag]
+		if !ok {
+			continue
+		}
+		n := int(u32(t, 4))
+		for i := 0; i < n && 8+48*i+48 <= len(t); i++ {
+			sizes = append(sizes, float64(t[8+48*i+45]))
+		}
+		break
+	}
+	return sizes
+}
+
+// capability the OpenType layout scripts of the GSUB and GPOS tables, like "otlayout:arab otlayout:latn"
+func (f sfnt) capability() string {
+	seen := make(map[string]bool)
+	var scripts []string
+	for _, tag := range []string{"GSUB", "GPOS"} {
+		t := f.tables[tag]
+		list := int(u16(t, 4))
+		if list == 0 {
+			continue
+		}
+		n := int(u16(t, list))
+		for i := 0; i < n && list+2+6*i+4 <= len(t); i++ {
+			script := "otlayout:" + strings.TrimSpace(string(t[list+2+6*i:list+2+6*i+4]))
+			if !seen[script] {
+				scripts = append(scripts, script)
+				seen[script] = true
+			}
+		}
+	}
+	sort.Strings(scripts)
+	return strings.Join(scripts, " ")
+}
+
+// cmapSubtable find the best unicode subtable of cmap
+func (f sfnt) cmapSubtable() []byte {
+	t := f.tables["cmap"]
+	n := int(u16(t, 2))
+
+	// full unicode tables are preferred over BMP only ones
+	priorities := [][2]uint16{{3, 10}, {0, 6}, {0, 4}, {3, 1}, {0, 3}, {0, 2}, {0, 1}, {0, 0}, {3, 0}}
+	best := len(priorities)
+	var sub []byte
+	for i := 0; i < n; i++ {
+		rec := 4 + 8*i
+		platform, encoding := u16(t, rec), u16(t, rec+2)
+		offset := int(u32(t, rec+4))
+		for p, prio := range priorities {
+			if prio[0] == platform && prio[1] == encoding && p < best && offset < len(t) {
+				best = p
+				sub = t[offset:]
+			}
+		}
+	}
+	return sub
+}
+
+// charset the code points mapped to a glyph by the cmap table
+func (f sfnt) charset() charset.Charset {
+	var b charsetBuilder
+	sub := f.cmapSubtable()
+
+	switch u16(sub, 0) {
+	case 0:
+		for c := 0; c < 256 && 6+c < len(sub); c++ {
+			if sub[6+c] != 0 {
+				b.add(uint64(c))
+			}
+		}
+	case 4:
+		// every array is bounded by the cmap table, not by the length field which overflows in big
+		// subtables. the segments are ascending, each code point is read once.
+		segX2 := int(u16(sub, 6)) &^ 1
+		if limit := (len(sub) - 16) / 4 * 2; segX2 > limit {
+			segX2 = limit
+		}
+		ends := 14
+		starts := ends + segX2 + 2
+		deltas := starts + segX2
+		rangeOffsets := deltas + segX2
+		next := 0
+		for i := 0; i < segX2/2; i++ {
+			end := int(u16(sub, ends+2*i))
+			first := int(u16(sub, starts+2*i))
+			delta := int(u16(sub, deltas+2*i))
+			ro := int(u16(sub, rangeOffsets+2*i))
+			glyphs := rangeOffsets + 2*i + ro
+			if last := first + (len(sub)-glyphs)/2 - 1; ro != 0 && end > last {
+				// the glyph ids of the segment must be in the table
+				end = last
+			}
+			start := first
+			if start < next {
+				start = next
+			}
+			for c := start; c <= end && c != 0xFFFF; c++ {
+				var glyph int
+				if ro == 0 {
+					glyph = (c + delta) & 0xFFFF
+				} else {
+					glyph = int(u16(sub, glyphs+2*(c-first)))
+					if glyph != 0 {
+						glyph = (glyph + delta) & 0xFFFF
+					}
+				}
+				if glyph != 0 {
+					b.add(uint64(c))
+				}
+			}
+			if end >= next {
+				next = end + 1
+			}
+		}
+	case 6:
+		first := int(u16(sub, 6))
+		count := int(u16(sub, 8))
+		for i := 0; i < count && 10+2*i+2 <= len(sub); i++ {
+			if u16(sub, 10+2*i) != 0 {
+				b.add(uint64(first + i))
+			}
+		}
+	case 12, 13:
+		n := int(u32(sub, 12))
+		for i := 0; i < n && 16+12*i+12 <= len(sub); i++ {
+			g := 16 + 12*i
+			start, end, glyph := u32(sub, g), u32(sub, g+4), u32(sub, g+8)
+			if end > 0x10FFFF || start > end {
+				continue
+			}
+			if glyph == 0 && u16(sub, 0) == 12 {
+				// only the first code point maps to .notdef
+				start++
+			}
+			if glyph == 0 && u16(sub, 0) == 13 {
+				continue
+			}
+			b.addRange(uint64(start), uint64(end))
+		}
+	}
+
+	return b.charset()
+}
+
+// charsetBuilder collect code points in ascending order into ranges
+type charsetBuilder struct {
+	ranges charset.Charset
+}
+
+func (b *charsetBuilder) add(c uint64) {
+	b.addRange(c, c)
+}
+
+func (b *charsetBuilder) addRange(min, max uint64) {
+	if min > max {
+		return
+	}
+	if n := len(b.ranges); n > 0 && b.ranges[n-1].Max+1 >= min && b.ranges[n-1].Min <= min {
+		if max > b.ranges[n-1].Max {
+			b.ranges[n-1].Max = max
+			b.ranges[n-1].Len = int(max - b.ranges[n-1].Min + 1)
+		}
+		return
+	}
+	b.ranges = append(b.ranges, charset.CharsetRange{Min: min, Max: max, Len: int(max - min + 1)})
+}
+
+// charset the collected ranges, sorted and merged
+func (b *charsetBuilder) charset() charset.Charset {
+	sort.Slice(b.ranges, func(i, j int) bool { return b.ranges[i].Min < b.ranges[j].Min })
+	var c charset.Charset
+	for _, r := range b.ranges {
+		if n := len(c); n > 0 && c[n-1].Max+1 >= r.Min {
+			if r.Max > c[n-1].Max {
+				c[n-1].Max = r.Max
+				c[n-1].Len = int(r.Max - c[n-1].Min + 1)
+			}
+			continue
+		}
+		c = append(c, r)
+	}
+	return c
+}
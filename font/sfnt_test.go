@@ -0,0 +1,281 @@
+package font
+
+import (
+	"encoding/binary"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"unicode/utf16"
+)
+
+// sfntName a record of the name table, a Windows Unicode one unless platform is 1
+type sfntName struct {
+	platform, lang, id uint16
+	value              string
+}
+
+func be16(v ...int) []byte {
+	b := make([]byte, 2*len(v))
+	for i, x := range v {
+		binary.BigEndian.PutUint16(b[2*i:], uint16(x))
+	}
+	return b
+}
+
+func be32(v ...uint32) []byte {
+	b := make([]byte, 4*len(v))
+	for i, x := range v {
+		binary.BigEndian.PutUint32(b[4*i:], x)
+	}
+	return b
+}
+
+// buildFace build the table directory and tables of a face placed at offset of the file
+func buildFace(offset int, tables map[string][]byte) []byte {
+	var tags []string
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	dir := append(be32(0x00010000), be16(len(tags), 0, 0, 0)...)
+	var data []byte
+	start := offset + 12 + 16*len(tags)
+	for _, tag := range tags {
+		t := tables[tag]
+		dir = append(dir, tag...)
+		dir = append(dir, be32(0, uint32(start+len(data)), uint32(len(t)))...)
+		data = append(data, t...)
+		for len(data)%4 != 0 {
+			data = append(data, 0)
+		}
+	}
+	return append(dir, data...)
+}
+
+// buildTTC build a font collection of faces
+func buildTTC(faces ...map[string][]byte) []byte {
+	header := append([]byte("ttcf"), be32(0x00010000, uint32(len(faces)))...)
+	offset := len(header) + 4*len(faces)
+	var data []byte
+	for _, tables := range faces {
+		header = append(header, be32(uint32(offset+len(data)))...)
+		data = append(data, buildFace(offset+len(data), tables)...)
+	}
+	return append(header, data...)
+}
+
+func nameTable(names ...sfntName) []byte {
+	var records, storage []byte
+	for _, n := range names {
+		var s []byte
+		encoding := 1
+		if n.platform == 1 {
+			encoding = 0
+			s = []byte(n.value)
+		} else {
+			for _, u := range utf16.Encode([]rune(n.value)) {
+				s = append(s, be16(int(u))...)
+			}
+		}
+		records = append(records, be16(int(n.platform), encoding, int(n.lang), int(n.id), len(s), len(storage))...)
+		storage = append(storage, s...)
+	}
+	t := be16(0, len(names), 6+len(records))
+	return append(append(t, records...), storage...)
+}
+
+// os2Table a version 4 OS/2 table
+func os2Table(weight, width, selection int, vendor string, codePages uint32) []byte {
+	t := make([]byte, 96)
+	copy(t, be16(4))
+	copy(t[4:], be16(weight, width))
+	copy(t[58:], vendor)
+	copy(t[62:], be16(selection))
+	copy(t[78:], be32(codePages))
+	return t
+}
+
+// cmapTable a cmap table with a single Windows subtable
+func cmapTable(encoding int, sub []byte) []byte {
+	return append(be16(0, 1, 3, encoding, 0, 12), sub...)
+}
+
+// cmap4 a format 4 subtable of segments {start, end, delta} without glyph arrays, ending with the 0xffff one
+func cmap4(segments ...[3]int) []byte {
+	segments = append(segments, [3]int{0xffff, 0xffff, 1})
+	var ends, starts, deltas, offsets []int
+	for _, s := range segments {
+		starts = append(starts, s[0])
+		ends = append(ends, s[1])
+		deltas = append(deltas, s[2])
+		offsets = append(offsets, 0)
+	}
+	n := len(segments)
+	sub := be16(4, 16+8*n, 0, 2*n, 0, 0, 0)
+	sub = append(sub, be16(ends...)...)
+	sub = append(sub, be16(0)...)
+	sub = append(sub, be16(starts...)...)
+	sub = append(sub, be16(deltas...)...)
+	return append(sub, be16(offsets...)...)
+}
+
+// cmap12 a format 12 subtable of groups {start, end, glyph}
+func cmap12(groups ...[3]uint32) []byte {
+	sub := append(be16(12, 0), be32(uint32(16+12*len(groups)), 0, uint32(len(groups)))...)
+	for _, g := range groups {
+		sub = append(sub, be32(g[0], g[1], g[2])...)
+	}
+	return sub
+}
+
+// testFace the tables of a bold italic Korean face covering ASCII letters
+func testFace() map[string][]byte {
+	return map[string][]byte{
+		"name": nameTable(
+			sfntName{3, 0x409, 1, "Test Sans Bold"},
+			sfntName{3, 0x409, 2, "Bold Italic"},
+			sfntName{3, 0x412, 1, "테스트"},
+			sfntName{3, 0x409, 4, "Test Sans Bold Italic"},
+			sfntName{3, 0x409, 6, "TestSans-BoldItalic"},
+			sfntName{3, 0x409, 16, "Test Sans"},
+			sfntName{1, 0, 1, "Test Sans Mac"},
+		),
+		"OS/2": os2Table(700, 3, 1, "TEST", 1<<19),
+		"cmap": cmapTable(1, cmap4([3]int{0x41, 0x5a, -0x40}, [3]int{0x61, 0x7a, -0x40})),
+		"glyf": {},
+	}
+}
+
+func TestParseSfnt(t *testing.T) {
+	faces, err := parseSfnt(buildFace(0, testFace()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(faces) != 1 {
+		t.Fatalf("%d faces", len(faces))
+	}
+	f := faces[0]
+
+	names := f.names()
+	want := sfntNames{
+		family:     []string{"Test Sans", "Test Sans Bold", "Test Sans Mac", "테스트"},
+		style:      []string{"Bold Italic"},
+		fullname:   []string{"Test Sans Bold Italic"},
+		postscript: "TestSans-BoldItalic",
+	}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("names %+v, want %+v", names, want)
+	}
+	if w := f.weight(); w != 200 {
+		t.Errorf("weight %d", w)
+	}
+	if w := f.width(); w != 75 {
+		t.Errorf("width %d", w)
+	}
+	if s := f.slant(); s != 100 {
+		t.Errorf("slant %d", s)
+	}
+	if v := f.foundry(); v != "TEST" {
+		t.Errorf("foundry %q", v)
+	}
+	if l := f.exclusiveLang(); l != "ko" {
+		t.Errorf("exclusive language %q", l)
+	}
+	if !f.outline() || !f.scalable() || f.color() || f.format() != "TrueType" {
+		t.Error("a TrueType outline font is not detected")
+	}
+	if c := strings.TrimSpace(f.charset().String()); c != "41-5a 61-7a" {
+		t.Errorf("charset %q", c)
+	}
+}
+
+func TestParseSfntCollection(t *testing.T) {
+	second := testFace()
+	second["name"] = nameTable(sfntName{3, 0x409, 1, "Test Mono"})
+	second["OS/2"] = os2Table(400, 5, 0, "", 1<<17|1<<19)
+	faces, err := parseSfnt(buildTTC(testFace(), second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(faces) != 2 {
+		t.Fatalf("%d faces", len(faces))
+	}
+	if n := faces[1].names().family; !reflect.DeepEqual(n, []string{"Test Mono"}) {
+		t.Errorf("family of the second face %q", n)
+	}
+	if w := faces[1].weight(); w != 80 {
+		t.Errorf("weight %d", w)
+	}
+	if v := faces[1].foundry(); v != "unknown" {
+		t.Errorf("foundry %q", v)
+	}
+	// two CJK code pages are not exclusive
+	if l := faces[1].exclusiveLang(); len(l) > 0 {
+		t.Errorf("exclusive language %q", l)
+	}
+}
+
+func TestParseSfntInvalid(t *testing.T) {
+	face := buildFace(0, testFace())
+	outOfBounds := append([]byte{}, face...)
+	// the length of the first table record
+	copy(outOfBounds[12+12:], be32(1<<20))
+
+	tests := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{"empty", nil, errNotSfnt},
+		{"short", face[:8], errNotSfnt},
+		{"not a font", []byte("<?xml version='1.0'?><fontconfig/>"), errNotSfnt},
+		{"truncated directory", face[:20], nil},
+		{"table out of bounds", outOfBounds, nil},
+		{"empty collection", append([]byte("ttcf"), be32(0x00010000, 0)...), nil},
+		{"truncated collection", append([]byte("ttcf"), be32(0x00010000, 1000)...), nil},
+	}
+	for _, tt := range tests {
+		_, err := parseSfnt(tt.data)
+		if err == nil || (tt.err != nil && err != tt.err) {
+			t.Errorf("%s: error %v, want %v", tt.name, err, tt.err)
+		}
+	}
+}
+
+func TestCharset(t *testing.T) {
+	charsetOf := func(encoding int, sub []byte) string {
+		return strings.TrimSpace(sfnt{tables: map[string][]byte{"cmap": cmapTable(encoding, sub)}}.charset().String())
+	}
+
+	// glyph 0 of a format 12 group is .notdef
+	if c := charsetOf(10, cmap12([3]uint32{0x20, 0x7e, 0}, [3]uint32{0x1f600, 0x1f64f, 100}, [3]uint32{0x110000, 0x110010, 1})); c != "21-7e 1f600-1f64f" {
+		t.Errorf("format 12: %q", c)
+	}
+
+	// a code point mapped to glyph 0 by its delta
+	if c := charsetOf(1, cmap4([3]int{0x30, 0x39, -0x30})); c != "31-39" {
+		t.Errorf("format 4: %q", c)
+	}
+
+	// every segment overlapping the whole BMP
+	segments := make([][3]int, 1000)
+	for i := range segments {
+		segments[i] = [3]int{0, 0xfffe, 1}
+	}
+	if c := charsetOf(1, cmap4(segments...)); c != "0-fffe" {
+		t.Errorf("overlapping segments: %q", c)
+	}
+
+	// glyph ids of a segment running past the end of the table
+	sub := be16(4, 0, 0, 2, 0, 0, 0, 0xfffe, 0, 0x100, 0, 2, 5, 0, 7)
+	if c := charsetOf(1, sub); c != "100 102" {
+		t.Errorf("glyph ids out of the table: %q", c)
+	}
+
+	// a segment count far beyond a truncated subtable
+	if c := charsetOf(1, be16(4, 0, 0, 0xfffe)); len(c) > 0 {
+		t.Errorf("truncated subtable: %q", c)
+	}
+}
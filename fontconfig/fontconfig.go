@@ -0,0 +1,404 @@
+package fontconfig
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/marguerite/fonts-config-ng/charset"
+)
+
+// Header the XML declaration and doctype of every fontconfig file
+const Header string = "<?xml version=\"1.0\"?>\n<!DOCTYPE fontconfig SYSTEM \"fonts.dtd\">\n"
+
+// Document a fontconfig configuration file
+type Document struct {
+	// Comments placed before the <fontconfig> element
+	Comments []string
+	// Attrs the attributes of the <fontconfig> element, Name.Space is the namespace prefix as written
+	Attrs []xml.Attr
+	Nodes []Node
+}
+
+// Append add nodes to the end of the document
+func (d *Document) Append(nodes ...Node) {
+	d.Nodes = append(d.Nodes, nodes...)
+}
+
+// Marshal serialize the document to well-formed fontconfig XML
+func (d Document) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(Header)
+	buf.WriteString("\n")
+
+	for _, c := range d.Comments {
+		s, err := marshalComment(c)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString(s + "\n")
+	}
+
+	buf.WriteString("<fontconfig")
+	for _, a := range d.Attrs {
+		name := a.Name.Local
+		if len(a.Name.Space) > 0 {
+			name = a.Name.Space + ":" + name
+		}
+		buf.WriteString(" " + name + "=\"")
+		err := xml.EscapeText(&buf, []byte(a.Value))
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("\"")
+	}
+	buf.WriteString(">\n")
+
+	for i, n := range d.Nodes {
+		if c, ok := n.(Comment); ok {
+			s, err := marshalComment(string(c))
+			if err != nil {
+				return nil, err
+			}
+			// a blank line before every group of comments
+			if i > 0 {
+				if _, ok := d.Nodes[i-1].(Comment); !ok {
+					buf.WriteString("\n")
+				}
+			}
+			buf.WriteString("\t" + s + "\n")
+			continue
+		}
+		// the raw markup keeps the namespace prefixes encoding/xml would rewrite
+		if el, ok := n.(Element); ok {
+			buf.WriteString("\t")
+			buf.Write(el.Raw)
+			buf.WriteString("\n")
+			continue
+		}
+		var node bytes.Buffer
+		e := xml.NewEncoder(&node)
+		e.Indent("\t", "\t")
+		err := e.Encode(n)
+		if err != nil {
+			return nil, err
+		}
+		switch v := n.(type) {
+		case Match:
+			err = insertComments(&buf, node.Bytes(), v.Comments)
+		case Alias:
+			err = insertComments(&buf, node.Bytes(), v.Comments)
+		default:
+			buf.Write(node.Bytes())
+		}
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("\n")
+	}
+
+	buf.WriteString("</fontconfig>\n")
+	return buf.Bytes(), nil
+}
+
+// marshalComment format s as XML comment. "--" is not allowed in comments.
+func marshalComment(s string) (string, error) {
+	if strings.Contains(s, "--") || strings.HasSuffix(s, "-") {
+		return "", fmt.Errorf("comment %q can not contain \"--\" or end with \"-\"", s)
+	}
+	return "<!--" + s + "-->", nil
+}
+
+// insertComments write the indented node to buf with comments put before the children they precede.
+// a child starts a line at the second level of indentation, character data can not as its tabs
+// are escaped.
+func insertComments(buf *bytes.Buffer, node []byte, comments map[int][]string) error {
+	lines := strings.Split(string(node), "\n")
+	// an element without children is written on one line
+	if len(lines) == 1 && len(comments[0]) > 0 {
+		end := strings.LastIndex(lines[0], "</")
+		lines = []string{lines[0][:end], "\t" + lines[0][end:]}
+	}
+	n := 0
+	for i, line := range lines {
+		child := strings.HasPrefix(line, "\t\t<") && !strings.HasPrefix(line, "\t\t</")
+		// the comments after the last child go before the end tag
+		if child || i == len(lines)-1 && i > 0 {
+			for _, c := range comments[n] {
+				s, err := marshalComment(c)
+				if err != nil {
+					return err
+				}
+				buf.WriteString("\t\t" + s + "\n")
+			}
+			n++
+		}
+		buf.WriteString(line)
+		if i < len(lines)-1 {
+			buf.WriteString("\n")
+		}
+	}
+	return nil
+}
+
+// Node an element of the <fontconfig> root element
+type Node interface {
+	node()
+}
+
+// Comment a comment between elements
+type Comment string
+
+// Match <match>, apply edits to a pattern or font when all tests are true
+type Match struct {
+	XMLName xml.Name `xml:"match"`
+	Target  string   `xml:"target,attr,omitempty"`
+	Tests   []Test
+	Edits   []Edit
+	// Comments the comments inside, by the index of the test or edit they precede
+	Comments map[int][]string `xml:"-"`
+	Line     int              `xml:"-"`
+}
+
+// Test <test>, compare an element of the pattern or font with a value
+type Test struct {
+	XMLName xml.Name `xml:"test"`
+	Name    string   `xml:"name,attr"`
+	Qual    string   `xml:"qual,attr,omitempty"`
+	Target  string   `xml:"target,attr,omitempty"`
+	Compare string   `xml:"compare,attr,omitempty"`
+	Values  []Expr
+	Line    int `xml:"-"`
+}
+
+// Edit <edit>, modify an element of the pattern or font
+type Edit struct {
+	XMLName xml.Name `xml:"edit"`
+	Name    string   `xml:"name,attr"`
+	Mode    string   `xml:"mode,attr,omitempty"`
+	Binding string   `xml:"binding,attr,omitempty"`
+	Values  []Expr
+	Line    int `xml:"-"`
+}
+
+// Alias <alias>, a family with preferred, accepted and default families
+type Alias struct {
+	XMLName xml.Name `xml:"alias"`
+	Binding string   `xml:"binding,attr,omitempty"`
+	Tests   []Test
+	Family  []string    `xml:"family"`
+	Prefer  *FamilyList `xml:"prefer"`
+	Accept  *FamilyList `xml:"accept"`
+	Default *FamilyList `xml:"default"`
+	// Comments the comments inside, by the index of the child they precede
+	Comments map[int][]string `xml:"-"`
+	Line     int              `xml:"-"`
+}
+
+// FamilyList the families of <prefer>, <accept> or <default>
+type FamilyList struct {
+	Families []string `xml:"family"`
+}
+
+// NewFamilyList initialize a FamilyList
+func NewFamilyList(families ...string) *FamilyList {
+	return &FamilyList{families}
+}
+
+// SelectFont <selectfont>, accept or reject fonts by glob or pattern
+type SelectFont struct {
+	XMLName xml.Name `xml:"selectfont"`
+	Accept  *FontSet `xml:"acceptfont"`
+	Reject  *FontSet `xml:"rejectfont"`
+	Line    int      `xml:"-"`
+}
+
+// FontSet the fonts of <acceptfont> or <rejectfont>
+type FontSet struct {
+	Globs    []string  `xml:"glob"`
+	Patterns []Pattern `xml:"pattern"`
+}
+
+// Pattern <pattern>, matches fonts having all the elements
+type Pattern struct {
+	Elts []PatElt `xml:"patelt"`
+}
+
+// PatElt <patelt>, an element of a pattern
+type PatElt struct {
+	Name   string `xml:"name,attr"`
+	Values []Expr
+}
+
+// Include <include>, load another configuration file or directory
+type Include struct {
+	XMLName       xml.Name `xml:"include"`
+	IgnoreMissing string   `xml:"ignore_missing,attr,omitempty"`
+	Prefix        string   `xml:"prefix,attr,omitempty"`
+	Path          string   `xml:",chardata"`
+	Line          int      `xml:"-"`
+}
+
+// Element any other element like <dir>, <cachedir> or <its:rules>, kept as is
+type Element struct {
+	// XMLName the name, with the namespace resolved from its prefix
+	XMLName xml.Name
+	// Raw the element as written in the file, from its start to its end tag
+	Raw  []byte
+	Line int
+}
+
+func (Comment) node()    {}
+func (Match) node()      {}
+func (Alias) node()      {}
+func (SelectFont) node() {}
+func (Include) node()    {}
+func (Element) node()    {}
+
+// Expr a value or an operation on values
+type Expr interface {
+	expr()
+}
+
+// String <string>
+type String string
+
+// Const <const>, a symbolic constant like hintslight or proportional
+type Const string
+
+// Name <name>, the value of another element of the pattern
+type Name string
+
+// Bool <bool>
+type Bool bool
+
+// Int <int>
+type Int int
+
+// Double <double>
+type Double float64
+
+// Charset <charset>
+type Charset charset.Charset
+
+// LangSet <langset>
+type LangSet []string
+
+// Op an operation like <minus> or <plus> on its arguments
+type Op struct {
+	Name string
+	Args []Expr
+}
+
+func (String) expr()  {}
+func (Const) expr()   {}
+func (Name) expr()    {}
+func (Bool) expr()    {}
+func (Int) expr()     {}
+func (Double) expr()  {}
+func (Charset) expr() {}
+func (LangSet) expr() {}
+func (Op) expr()      {}
+
+// encodeValue encode s as the character data of element name
+func encodeValue(e *xml.Encoder, name, s string) error {
+	return e.EncodeElement(s, xml.StartElement{Name: xml.Name{Local: name}})
+}
+
+// MarshalXML encode s as <string>
+func (s String) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeValue(e, "string", string(s))
+}
+
+// MarshalXML encode c as <const>
+func (c Const) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeValue(e, "const", string(c))
+}
+
+// MarshalXML encode n as <name>
+func (n Name) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeValue(e, "name", string(n))
+}
+
+// MarshalXML encode b as <bool>
+func (b Bool) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeValue(e, "bool", strconv.FormatBool(bool(b)))
+}
+
+// MarshalXML encode i as <int>
+func (i Int) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeValue(e, "int", strconv.Itoa(int(i)))
+}
+
+// MarshalXML encode d as <double>
+func (d Double) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeValue(e, "double", strconv.FormatFloat(float64(d), 'f', -1, 64))
+}
+
+// MarshalXML encode c as <charset> of hexadecimal <int> and <range>
+func (c Charset) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	s := xml.StartElement{Name: xml.Name{Local: "charset"}}
+	err := e.EncodeToken(s)
+	if err != nil {
+		return err
+	}
+	for _, v := range c {
+		if v.Min == v.Max {
+			err = encodeValue(e, "int", "0x"+strconv.FormatUint(v.Min, 16))
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		r := xml.StartElement{Name: xml.Name{Local: "range"}}
+		err = e.EncodeToken(r)
+		if err != nil {
+			return err
+		}
+		for _, i := range []uint64{v.Min, v.Max} {
+			err = encodeValue(e, "int", "0x"+strconv.FormatUint(i, 16))
+			if err != nil {
+				return err
+			}
+		}
+		err = e.EncodeToken(r.End())
+		if err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(s.End())
+}
+
+// MarshalXML encode l as <langset> of <string>
+func (l LangSet) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	s := xml.StartElement{Name: xml.Name{Local: "langset"}}
+	err := e.EncodeToken(s)
+	if err != nil {
+		return err
+	}
+	for _, lang := range l {
+		err = encodeValue(e, "string", lang)
+		if err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(s.End())
+}
+
+// MarshalXML encode o as an element named after the operation with its arguments as children
+func (o Op) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	s := xml.StartElement{Name: xml.Name{Local: o.Name}}
+	err := e.EncodeToken(s)
+	if err != nil {
+		return err
+	}
+	for _, arg := range o.Args {
+		err = e.Encode(arg)
+		if err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(s.End())
+}
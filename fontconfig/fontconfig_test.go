@@ -0,0 +1,75 @@
+package fontconfig
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/marguerite/fonts-config-ng/charset"
+)
+
+func TestMarshalExpr(t *testing.T) {
+	tests := []struct {
+		expr Expr
+		want string
+	}{
+		{String("Noto Sans <CJK>"), "<string>Noto Sans &lt;CJK&gt;</string>"},
+		{Const("hintslight"), "<const>hintslight</const>"},
+		{Name("pixelsize"), "<name>pixelsize</name>"},
+		{Bool(true), "<bool>true</bool>"},
+		{Int(-3), "<int>-3</int>"},
+		{Double(1.5), "<double>1.5</double>"},
+		{Double(2), "<double>2</double>"},
+		{Charset(charset.NewCharset("20 1f600-1f64f")),
+			"<charset><int>0x20</int><range><int>0x1f600</int><int>0x1f64f</int></range></charset>"},
+		{Charset(nil), "<charset></charset>"},
+		{LangSet{"zh-cn", "ja"}, "<langset><string>zh-cn</string><string>ja</string></langset>"},
+		{Op{Name: "times", Args: []Expr{Name("pixelsize"), Double(0.5)}}, "<times><name>pixelsize</name><double>0.5</double></times>"},
+		{Op{Name: "if", Args: []Expr{Bool(true), Op{Name: "not", Args: []Expr{Bool(false)}}, Int(1)}},
+			"<if><bool>true</bool><not><bool>false</bool></not><int>1</int></if>"},
+	}
+	for _, tt := range tests {
+		b, err := xml.Marshal(tt.expr)
+		if err != nil {
+			t.Errorf("%#v: %s", tt.expr, err)
+			continue
+		}
+		if string(b) != tt.want {
+			t.Errorf("%#v: got %s, want %s", tt.expr, b, tt.want)
+		}
+	}
+}
+
+func TestMarshalNodes(t *testing.T) {
+	tests := []struct {
+		name string
+		node Node
+		want string
+	}{
+		{"comment", Comment(" note "), "<!-- note -->"},
+		{"include", Include{IgnoreMissing: "yes", Prefix: "xdg", Path: "fontconfig/fonts.conf"},
+			`<include ignore_missing="yes" prefix="xdg">fontconfig/fonts.conf</include>`},
+		{"alias without lists", Alias{Binding: "same", Family: []string{"Arial"}},
+			"<alias binding=\"same\">\n\t\t<family>Arial</family>\n\t</alias>"},
+		{"edit without values", Match{Edits: []Edit{{Name: "family", Mode: "delete_all"}}},
+			"<match>\n\t\t<edit name=\"family\" mode=\"delete_all\"></edit>\n\t</match>"},
+		{"selectfont", SelectFont{Reject: &FontSet{Globs: []string{"/usr/share/fonts/bitmap/*"}}},
+			"<selectfont>\n\t\t<rejectfont>\n\t\t\t<glob>/usr/share/fonts/bitmap/*</glob>\n\t\t</rejectfont>\n\t</selectfont>"},
+	}
+	for _, tt := range tests {
+		doc := &Document{}
+		doc.Append(tt.node)
+		b, err := doc.Marshal()
+		if err != nil {
+			t.Errorf("%s: %s", tt.name, err)
+			continue
+		}
+		want := Header + "\n<fontconfig>\n\t" + tt.want + "\n</fontconfig>\n"
+		if string(b) != want {
+			t.Errorf("%s: got\n%s\nwant\n%s", tt.name, b, want)
+		}
+		if _, err := Parse(strings.NewReader(string(b))); err != nil {
+			t.Errorf("%s: marshaled document does not parse: %s", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,470 @@
+package fontconfig
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/marguerite/fonts-config-ng/charset"
+)
+
+// parser decode a fontconfig file token by token, keeping track of line numbers
+type parser struct {
+	d        *xml.Decoder
+	data     []byte
+	newlines []int
+}
+
+// ParseFile parse the fontconfig configuration file at path
+func ParseFile(path string) (*Document, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	doc, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err.Error())
+	}
+	return doc, nil
+}
+
+// Parse parse a fontconfig configuration file into a Document
+func Parse(r io.Reader) (*Document, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	p := parser{d: xml.NewDecoder(bytes.NewReader(data)), data: data}
+	for i, b := range data {
+		if b == '\n' {
+			p.newlines = append(p.newlines, i)
+		}
+	}
+
+	return p.document()
+}
+
+// line the line the decoder is at
+func (p parser) line() int {
+	offset := int(p.d.InputOffset())
+	return sort.Search(len(p.newlines), func(i int) bool { return p.newlines[i] >= offset }) + 1
+}
+
+// errorf an error at the current line
+func (p parser) errorf(format string, a ...interface{}) error {
+	return fmt.Errorf("line %d: %s", p.line(), fmt.Sprintf(format, a...))
+}
+
+func (p parser) document() (*Document, error) {
+	doc := &Document{}
+
+	for {
+		offset := p.d.InputOffset()
+		tok, err := p.d.Token()
+		if err == io.EOF {
+			return nil, p.errorf("no <fontconfig> element")
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.Comment:
+			doc.Comments = append(doc.Comments, string(t))
+		case xml.StartElement:
+			if t.Name.Local != "fontconfig" {
+				return nil, p.errorf("root element is <%s>, not <fontconfig>", t.Name.Local)
+			}
+			// the raw start tag, Token resolves the namespace prefixes of the attributes
+			raw, err := xml.NewDecoder(bytes.NewReader(p.data[offset:p.d.InputOffset()])).RawToken()
+			if err != nil {
+				return nil, err
+			}
+			doc.Attrs = raw.(xml.StartElement).Attr
+			doc.Nodes, err = p.nodes()
+			return doc, err
+		}
+	}
+}
+
+// nodes parse the children of <fontconfig>
+func (p parser) nodes() ([]Node, error) {
+	var nodes []Node
+
+	for {
+		// where the next element starts, to keep unknown ones as written
+		offset := p.d.InputOffset()
+		tok, err := p.d.Token()
+		if err != nil {
+			return nodes, err
+		}
+		switch t := tok.(type) {
+		case xml.Comment:
+			nodes = append(nodes, Comment(t))
+		case xml.EndElement:
+			return nodes, nil
+		case xml.StartElement:
+			var n Node
+			switch t.Name.Local {
+			case "match":
+				n, err = p.match(t)
+			case "alias":
+				n, err = p.alias(t)
+			case "selectfont":
+				n, err = p.selectFont(t)
+			case "include":
+				n, err = p.include(t)
+			default:
+				el := Element{XMLName: t.Name, Line: p.line()}
+				err = p.d.Skip()
+				el.Raw = p.data[offset:p.d.InputOffset()]
+				n = el
+			}
+			if err != nil {
+				return nodes, err
+			}
+			nodes = append(nodes, n)
+		}
+	}
+}
+
+// attr the value of attribute name of element start
+func attr(start xml.StartElement, name string) string {
+	for _, a := range start.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// children call fn for every child element of the current element, until its end
+func (p parser) children(fn func(xml.StartElement) error) error {
+	for {
+		tok, err := p.d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.EndElement:
+			return nil
+		case xml.StartElement:
+			err = fn(t)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// commentedChildren call fn for every child element of the current element like children, and keep
+// the comments between them by the index of the child they precede
+func (p parser) commentedChildren(comments *map[int][]string, fn func(xml.StartElement) error) error {
+	n := 0
+	for {
+		tok, err := p.d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.Comment:
+			if *comments == nil {
+				*comments = make(map[int][]string)
+			}
+			(*comments)[n] = append((*comments)[n], string(t))
+		case xml.EndElement:
+			return nil
+		case xml.StartElement:
+			err = fn(t)
+			if err != nil {
+				return err
+			}
+			n++
+		}
+	}
+}
+
+// text the character data of the current element, until its end, as written
+func (p parser) text() (string, error) {
+	var str string
+	for {
+		tok, err := p.d.Token()
+		if err != nil {
+			return str, err
+		}
+		switch t := tok.(type) {
+		case xml.CharData:
+			str += string(t)
+		case xml.EndElement:
+			return str, nil
+		case xml.StartElement:
+			return str, p.errorf("unexpected <%s> in text", t.Name.Local)
+		}
+	}
+}
+
+func (p parser) match(start xml.StartElement) (Match, error) {
+	m := Match{Target: attr(start, "target"), Line: p.line()}
+	err := p.commentedChildren(&m.Comments, func(t xml.StartElement) error {
+		switch t.Name.Local {
+		case "test":
+			test, err := p.test(t)
+			m.Tests = append(m.Tests, test)
+			return err
+		case "edit":
+			edit, err := p.edit(t)
+			m.Edits = append(m.Edits, edit)
+			return err
+		}
+		return p.errorf("unexpected <%s> in <match>", t.Name.Local)
+	})
+	return m, err
+}
+
+func (p parser) test(start xml.StartElement) (Test, error) {
+	test := Test{Name: attr(start, "name"), Qual: attr(start, "qual"), Target: attr(start, "target"),
+		Compare: attr(start, "compare"), Line: p.line()}
+	err := p.children(func(t xml.StartElement) error {
+		v, err := p.expr(t)
+		test.Values = append(test.Values, v)
+		return err
+	})
+	return test, err
+}
+
+func (p parser) edit(start xml.StartElement) (Edit, error) {
+	edit := Edit{Name: attr(start, "name"), Mode: attr(start, "mode"), Binding: attr(start, "binding"), Line: p.line()}
+	err := p.children(func(t xml.StartElement) error {
+		v, err := p.expr(t)
+		edit.Values = append(edit.Values, v)
+		return err
+	})
+	return edit, err
+}
+
+func (p parser) familyList() (*FamilyList, error) {
+	l := &FamilyList{}
+	err := p.children(func(t xml.StartElement) error {
+		if t.Name.Local != "family" {
+			return p.errorf("unexpected <%s> in family list", t.Name.Local)
+		}
+		family, err := p.text()
+		l.Families = append(l.Families, family)
+		return err
+	})
+	return l, err
+}
+
+func (p parser) alias(start xml.StartElement) (Alias, error) {
+	a := Alias{Binding: attr(start, "binding"), Line: p.line()}
+	err := p.commentedChildren(&a.Comments, func(t xml.StartElement) error {
+		var err error
+		switch t.Name.Local {
+		case "test":
+			var test Test
+			test, err = p.test(t)
+			a.Tests = append(a.Tests, test)
+		case "family":
+			var family string
+			family, err = p.text()
+			a.Family = append(a.Family, family)
+		case "prefer":
+			a.Prefer, err = p.familyList()
+		case "accept":
+			a.Accept, err = p.familyList()
+		case "default":
+			a.Default, err = p.familyList()
+		default:
+			err = p.errorf("unexpected <%s> in <alias>", t.Name.Local)
+		}
+		return err
+	})
+	return a, err
+}
+
+func (p parser) fontSet() (*FontSet, error) {
+	fs := &FontSet{}
+	err := p.children(func(t xml.StartElement) error {
+		switch t.Name.Local {
+		case "glob":
+			glob, err := p.text()
+			fs.Globs = append(fs.Globs, glob)
+			return err
+		case "pattern":
+			var pat Pattern
+			err := p.children(func(t1 xml.StartElement) error {
+				if t1.Name.Local != "patelt" {
+					return p.errorf("unexpected <%s> in <pattern>", t1.Name.Local)
+				}
+				elt := PatElt{Name: attr(t1, "name")}
+				err := p.children(func(t2 xml.StartElement) error {
+					v, err := p.expr(t2)
+					elt.Values = append(elt.Values, v)
+					return err
+				})
+				pat.Elts = append(pat.Elts, elt)
+				return err
+			})
+			fs.Patterns = append(fs.Patterns, pat)
+			return err
+		}
+		return p.errorf("unexpected <%s> in font set", t.Name.Local)
+	})
+	return fs, err
+}
+
+func (p parser) selectFont(start xml.StartElement) (SelectFont, error) {
+	sf := SelectFont{Line: p.line()}
+	err := p.children(func(t xml.StartElement) error {
+		var err error
+		switch t.Name.Local {
+		case "acceptfont":
+			sf.Accept, err = p.fontSet()
+		case "rejectfont":
+			sf.Reject, err = p.fontSet()
+		default:
+			err = p.errorf("unexpected <%s> in <selectfont>", t.Name.Local)
+		}
+		return err
+	})
+	return sf, err
+}
+
+func (p parser) include(start xml.StartElement) (Include, error) {
+	inc := Include{IgnoreMissing: attr(start, "ignore_missing"), Prefix: attr(start, "prefix"), Line: p.line()}
+	path, err := p.text()
+	inc.Path = path
+	return inc, err
+}
+
+// parseBool parse a fontconfig boolean
+func parseBool(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "true", "yes", "on", "t", "y", "1":
+		return true, nil
+	case "false", "no", "off", "f", "n", "0":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid bool %q", s)
+}
+
+// parseInt parse a decimal, hexadecimal or octal integer
+func parseInt(s string) (int64, error) {
+	return strconv.ParseInt(s, 0, 64)
+}
+
+// expr parse the value or operation start
+func (p parser) expr(start xml.StartElement) (Expr, error) {
+	switch start.Name.Local {
+	case "string", "const", "name", "bool", "int", "double":
+		s, err := p.text()
+		if err != nil {
+			return nil, err
+		}
+		// whitespace is part of a string, not of a number or symbol
+		if start.Name.Local != "string" && start.Name.Local != "name" {
+			s = strings.TrimSpace(s)
+		}
+		switch start.Name.Local {
+		case "string":
+			return String(s), nil
+		case "const":
+			return Const(s), nil
+		case "name":
+			return Name(s), nil
+		case "bool":
+			b, err := parseBool(s)
+			if err != nil {
+				return nil, p.errorf("%s", err)
+			}
+			return Bool(b), nil
+		case "int":
+			i, err := parseInt(s)
+			if err != nil {
+				return nil, p.errorf("invalid int %q", s)
+			}
+			return Int(i), nil
+		default:
+			d, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return nil, p.errorf("invalid double %q", s)
+			}
+			return Double(d), nil
+		}
+	case "charset":
+		return p.charset()
+	case "langset":
+		var l LangSet
+		err := p.children(func(t xml.StartElement) error {
+			if t.Name.Local != "string" {
+				return p.errorf("unexpected <%s> in <langset>", t.Name.Local)
+			}
+			s, err := p.text()
+			l = append(l, s)
+			return err
+		})
+		return l, err
+	}
+
+	op := Op{Name: start.Name.Local}
+	err := p.children(func(t xml.StartElement) error {
+		v, err := p.expr(t)
+		op.Args = append(op.Args, v)
+		return err
+	})
+	return op, err
+}
+
+// charset parse the <int> and <range> of a <charset>
+func (p parser) charset() (Charset, error) {
+	var c charset.Charset
+	readInt := func() (uint64, error) {
+		s, err := p.text()
+		if err != nil {
+			return 0, err
+		}
+		s = strings.TrimSpace(s)
+		i, err := parseInt(s)
+		if err != nil || i < 0 || uint64(i) > charset.MaxCodePoint {
+			return 0, p.errorf("invalid code point %q", s)
+		}
+		return uint64(i), nil
+	}
+
+	err := p.children(func(t xml.StartElement) error {
+		switch t.Name.Local {
+		case "int":
+			i, err := readInt()
+			c = append(c, charset.CharsetRange{Min: i, Max: i, Len: 1})
+			return err
+		case "range":
+			var r []uint64
+			err := p.children(func(t1 xml.StartElement) error {
+				if t1.Name.Local != "int" {
+					return p.errorf("unexpected <%s> in <range>", t1.Name.Local)
+				}
+				i, err := readInt()
+				r = append(r, i)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			if len(r) != 2 || r[0] > r[1] {
+				return p.errorf("a <range> needs two ascending <int>")
+			}
+			c = append(c, charset.CharsetRange{Min: r[0], Max: r[1], Len: int(r[1] - r[0] + 1)})
+			return nil
+		}
+		return p.errorf("unexpected <%s> in <charset>", t.Name.Local)
+	})
+	return Charset(c), err
+}
@@ -0,0 +1,270 @@
+package fontconfig
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/marguerite/fonts-config-ng/charset"
+)
+
+// shipped a configuration file like the ones fontconfig ships, with every kind of node
+const shipped = `<?xml version="1.0"?>
+<!DOCTYPE fontconfig SYSTEM "urn:fontconfig:fonts.dtd">
+<!-- generated -->
+<fontconfig>
+  <its:rules xmlns:its="http://www.w3.org/2005/11/its" version="1.0">
+    <its:translateRule translate="no" selector="/fontconfig/*[not(self::description)]"/>
+  </its:rules>
+  <description>Test configuration</description>
+  <dir prefix="xdg">fonts</dir>
+  <!-- rendering -->
+  <match target="font">
+    <test name="family" compare="contains" qual="any">
+      <string>DejaVu</string>
+    </test>
+    <edit name="hintstyle" mode="assign" binding="strong">
+      <const>hintslight</const>
+    </edit>
+    <edit name="pixelsize" mode="assign">
+      <times><name>pixelsize</name><double>1.5</double></times>
+    </edit>
+    <edit name="charset" mode="assign_replace">
+      <minus>
+        <name>charset</name>
+        <charset><int>0x20</int><range><int>0x1f600</int><int>0x1f64f</int></range></charset>
+      </minus>
+    </edit>
+  </match>
+  <alias binding="same">
+    <family>Helvetica</family>
+    <prefer><family>Nimbus Sans</family></prefer>
+    <default><family>sans-serif</family></default>
+  </alias>
+  <selectfont>
+    <rejectfont>
+      <glob>/usr/share/fonts/bitmap/*</glob>
+      <pattern><patelt name="scalable"><bool>false</bool></patelt></pattern>
+    </rejectfont>
+  </selectfont>
+  <include ignore_missing="yes" prefix="xdg">fontconfig/conf.d</include>
+</fontconfig>
+`
+
+func TestParse(t *testing.T) {
+	doc, err := Parse(strings.NewReader(shipped))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(doc.Comments, []string{" generated "}) {
+		t.Errorf("comments %q", doc.Comments)
+	}
+	if len(doc.Nodes) != 8 {
+		t.Fatalf("%d nodes, want 8", len(doc.Nodes))
+	}
+
+	rules, ok := doc.Nodes[0].(Element)
+	if !ok || rules.XMLName.Local != "rules" || rules.XMLName.Space != "http://www.w3.org/2005/11/its" {
+		t.Errorf("its:rules parsed as %#v", doc.Nodes[0])
+	}
+	if !strings.HasPrefix(string(rules.Raw), `<its:rules xmlns:its=`) || !strings.HasSuffix(string(rules.Raw), "</its:rules>") {
+		t.Errorf("its:rules kept as %q", rules.Raw)
+	}
+
+	m, ok := doc.Nodes[4].(Match)
+	if !ok {
+		t.Fatalf("node 4 is %#v, want a Match", doc.Nodes[4])
+	}
+	if m.Line != 11 || m.Tests[0].Line != 12 || m.Edits[0].Line != 15 {
+		t.Errorf("lines %d %d %d, want 11 12 15", m.Line, m.Tests[0].Line, m.Edits[0].Line)
+	}
+	wantTest := Test{Name: "family", Qual: "any", Compare: "contains", Values: []Expr{String("DejaVu")}}
+	if got := m.Tests[0]; got.Name != wantTest.Name || got.Qual != wantTest.Qual || got.Compare != wantTest.Compare ||
+		!reflect.DeepEqual(got.Values, wantTest.Values) {
+		t.Errorf("test %#v", got)
+	}
+	wantTimes := Op{Name: "times", Args: []Expr{Name("pixelsize"), Double(1.5)}}
+	if !reflect.DeepEqual(m.Edits[1].Values, []Expr{wantTimes}) {
+		t.Errorf("times %#v", m.Edits[1].Values)
+	}
+	wantCharset := Charset(charset.NewCharset("20 1f600-1f64f"))
+	if minus := m.Edits[2].Values[0].(Op); !reflect.DeepEqual(minus.Args[1], wantCharset) {
+		t.Errorf("charset %#v", minus.Args[1])
+	}
+
+	a := doc.Nodes[5].(Alias)
+	if a.Binding != "same" || !reflect.DeepEqual(a.Family, []string{"Helvetica"}) ||
+		!reflect.DeepEqual(a.Prefer.Families, []string{"Nimbus Sans"}) || a.Accept != nil {
+		t.Errorf("alias %#v", a)
+	}
+	inc := doc.Nodes[7].(Include)
+	if inc.Path != "fontconfig/conf.d" || inc.IgnoreMissing != "yes" || inc.Prefix != "xdg" {
+		t.Errorf("include %#v", inc)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	doc, err := Parse(strings.NewReader(shipped))
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, err := doc.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc1, err := Parse(strings.NewReader(string(first)))
+	if err != nil {
+		t.Fatalf("marshaled document does not parse: %s\n%s", err, first)
+	}
+	second, err := doc1.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(first) != string(second) {
+		t.Errorf("marshal is not stable:\n%s\n---\n%s", first, second)
+	}
+	if len(doc1.Nodes) != len(doc.Nodes) {
+		t.Errorf("%d nodes after a round trip, want %d", len(doc1.Nodes), len(doc.Nodes))
+	}
+	// the namespace prefix survives
+	if !strings.Contains(string(first), `<its:translateRule translate="no"`) || strings.Contains(string(first), "_xmlns") {
+		t.Errorf("its:rules rewritten:\n%s", first)
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	doc := &Document{Comments: []string{" DO NOT EDIT "}}
+	doc.Append(Comment(" prefer "),
+		Alias{Family: []string{"sans-serif"}, Prefer: NewFamilyList("Noto Sans")},
+		Match{Target: "scan", Tests: []Test{{Name: "family", Values: []Expr{String("A & B")}}},
+			Edits: []Edit{{Name: "embeddedbitmap", Mode: "assign", Values: []Expr{Bool(false)}}}})
+	b, err := doc.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Header + `
+<!-- DO NOT EDIT -->
+<fontconfig>
+	<!-- prefer -->
+	<alias>
+		<family>sans-serif</family>
+		<prefer>
+			<family>Noto Sans</family>
+		</prefer>
+	</alias>
+	<match target="scan">
+		<test name="family">
+			<string>A &amp; B</string>
+		</test>
+		<edit name="embeddedbitmap" mode="assign">
+			<bool>false</bool>
+		</edit>
+	</match>
+</fontconfig>
+`
+	if string(b) != want {
+		t.Errorf("got\n%s\nwant\n%s", b, want)
+	}
+
+	doc.Append(Comment(" -- "))
+	if _, err := doc.Marshal(); err == nil {
+		t.Error("marshaled a comment containing --")
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{`<foo/>`, "root element is <foo>"},
+		{"<fontconfig>\n<match><test name=\"x\"><bool>maybe</bool></test></match></fontconfig>", `line 2: invalid bool "maybe"`},
+		{"<fontconfig><match><test name=\"x\"><int>x1</int></test></match></fontconfig>", `invalid int "x1"`},
+		{"<fontconfig><match><edit name=\"charset\"><charset><range><int>2</int><int>1</int></range></charset></edit></match></fontconfig>", "two ascending"},
+		{"<fontconfig><match><edit name=\"charset\"><charset><range><int>0</int><int>0xffffffff</int></range></charset></edit></match></fontconfig>", `invalid code point "0xffffffff"`},
+		{"<fontconfig><match><edit name=\"charset\"><charset><int>0x110000</int></charset></edit></match></fontconfig>", `invalid code point "0x110000"`},
+		{"<fontconfig><alias><foo/></alias></fontconfig>", "unexpected <foo> in <alias>"},
+	}
+	for _, tt := range tests {
+		_, err := Parse(strings.NewReader(tt.in))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Parse(%q) = %v, want %q", tt.in, err, tt.want)
+		}
+	}
+}
+
+func TestParseKeepsFile(t *testing.T) {
+	in := `<?xml version="1.0"?>
+<!DOCTYPE fontconfig SYSTEM "fonts.dtd">
+<fontconfig xmlns:its="http://www.w3.org/2005/11/its" its:version="2.0">
+	<match target="font">
+		<!-- the family -->
+		<test name="family">
+			<string> Sans  Mono </string>
+		</test>
+		<!-- hinting -->
+		<!-- slight -->
+		<edit name="hintstyle" mode="assign">
+			<const> hintslight
+			</const>
+		</edit>
+		<edit name="pixelsize" mode="assign">
+			<int> 12 </int>
+		</edit>
+		<!-- done -->
+	</match>
+	<alias>
+		<family>Helvetica</family>
+		<!-- metric compatible -->
+		<prefer>
+			<family>Nimbus Sans</family>
+		</prefer>
+	</alias>
+	<alias>
+		<!-- empty -->
+	</alias>
+</fontconfig>
+`
+	doc, err := Parse(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := doc.Nodes[0].(Match)
+	if got := m.Tests[0].Values[0]; got != String(" Sans  Mono ") {
+		t.Errorf("string %q, want its whitespace kept", got)
+	}
+	if got := m.Edits[0].Values[0]; got != Const("hintslight") {
+		t.Errorf("const %q", got)
+	}
+	if got := m.Edits[1].Values[0]; got != Int(12) {
+		t.Errorf("int %v", got)
+	}
+	want := map[int][]string{0: {" the family "}, 1: {" hinting ", " slight "}, 3: {" done "}}
+	if !reflect.DeepEqual(m.Comments, want) {
+		t.Errorf("comments %q, want %q", m.Comments, want)
+	}
+
+	b, err := doc.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		`<fontconfig xmlns:its="http://www.w3.org/2005/11/its" its:version="2.0">`,
+		"\t\t<!-- the family -->\n\t\t<test name=\"family\">",
+		"\t\t<!-- hinting -->\n\t\t<!-- slight -->\n\t\t<edit name=\"hintstyle\"",
+		"\t\t<!-- done -->\n\t</match>",
+		"\t\t<!-- metric compatible -->\n\t\t<prefer>",
+		"\t<alias>\n\t\t<!-- empty -->\n\t</alias>",
+		"<string> Sans  Mono </string>",
+	} {
+		if !strings.Contains(string(b), s) {
+			t.Errorf("%q lost in\n%s", s, b)
+		}
+	}
+	doc1, err := Parse(strings.NewReader(string(b)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(doc1.Attrs, doc.Attrs) || !reflect.DeepEqual(doc1.Nodes[1].(Alias).Comments, doc.Nodes[1].(Alias).Comments) {
+		t.Errorf("round trip changed the document:\n%s", b)
+	}
+}
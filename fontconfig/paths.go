@@ -0,0 +1,129 @@
+package fontconfig
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ConfigFile the configuration file fontconfig starts from
+const ConfigFile string = "/etc/fonts/fonts.conf"
+
+// xdgDir the XDG base directory of the variable env, fallback in the home directory when it is unset
+func xdgDir(env, fallback string) string {
+	if dir := os.Getenv(env); len(dir) > 0 {
+		return dir
+	}
+	return filepath.Join(os.Getenv("HOME"), fallback)
+}
+
+// resolvePath the path in sysroot of a path with a prefix attribute written in the configuration file.
+// paths in the home directory are only resolved in userMode. relative paths without prefix are
+// relative to the configuration directory.
+func resolvePath(path, prefix, xdgEnv, xdgFallback, file, sysroot string, userMode bool) (string, bool) {
+	switch {
+	case prefix == "xdg":
+		if !userMode {
+			return "", false
+		}
+		path = filepath.Join(xdgDir(xdgEnv, xdgFallback), path)
+	case strings.HasPrefix(path, "~"):
+		if !userMode {
+			return "", false
+		}
+		path = filepath.Join(os.Getenv("HOME"), strings.TrimPrefix(path, "~"))
+	case prefix == "relative":
+		rel, err := filepath.Rel(sysroot, filepath.Dir(file))
+		if err != nil {
+			return "", false
+		}
+		path = filepath.Join("/", rel, path)
+	case !filepath.IsAbs(path):
+		path = filepath.Join(filepath.Dir(ConfigFile), path)
+	}
+	return filepath.Join(sysroot, path), true
+}
+
+// ResolveInclude the configuration files an <include> in file refers to, in load order. file and the
+// files returned are paths in sysroot, includes of the user's configuration are only followed in userMode.
+func ResolveInclude(inc Include, file, sysroot string, userMode bool) []string {
+	path, ok := resolvePath(strings.TrimSpace(inc.Path), inc.Prefix, "XDG_CONFIG_HOME", ".config", file, sysroot, userMode)
+	if !ok {
+		return nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil
+	}
+	if !info.IsDir() {
+		return []string{path}
+	}
+	files, _ := filepath.Glob(filepath.Join(path, "*.conf"))
+	sort.Strings(files)
+	return files
+}
+
+// FontDirs the font directories of the <dir> and <remap-dir> elements of the configuration installed
+// into sysroot, relative to it, in the order fontconfig reads them. the directories in the home directory
+// are only returned in userMode. without any, the directories fontconfig falls back to are returned.
+func FontDirs(sysroot string, userMode bool) []string {
+	var dirs []string
+	seen := make(map[string]bool)
+	visited := make(map[string]bool)
+
+	var load func(file string)
+	load = func(file string) {
+		if visited[file] {
+			return
+		}
+		visited[file] = true
+		doc, err := ParseFile(file)
+		if err != nil {
+			return
+		}
+		for _, n := range doc.Nodes {
+			switch t := n.(type) {
+			case Include:
+				for _, f := range ResolveInclude(t, file, sysroot, userMode) {
+					load(f)
+				}
+			case Element:
+				if len(t.XMLName.Space) > 0 || (t.XMLName.Local != "dir" && t.XMLName.Local != "remap-dir") {
+					continue
+				}
+				var dir struct {
+					Prefix string `xml:"prefix,attr"`
+					Path   string `xml:",chardata"`
+				}
+				if xml.Unmarshal(t.Raw, &dir) != nil {
+					continue
+				}
+				path := strings.TrimSpace(dir.Path)
+				// a relative path without prefix is relative to the working directory of the application
+				if len(path) == 0 || (!filepath.IsAbs(path) && !strings.HasPrefix(path, "~") && dir.Prefix != "xdg" && dir.Prefix != "relative") {
+					continue
+				}
+				if path, ok := resolvePath(path, dir.Prefix, "XDG_DATA_HOME", ".local/share", file, sysroot, userMode); ok {
+					rel, err := filepath.Rel(sysroot, path)
+					if err != nil || seen[rel] {
+						continue
+					}
+					seen[rel] = true
+					dirs = append(dirs, filepath.Join("/", rel))
+				}
+			}
+		}
+	}
+	load(filepath.Join(sysroot, ConfigFile))
+
+	if len(dirs) == 0 {
+		dirs = append(dirs, "/usr/share/fonts")
+		if userMode {
+			dirs = append(dirs, filepath.Join(xdgDir("XDG_DATA_HOME", ".local/share"), "fonts"))
+		}
+	}
+	return dirs
+}
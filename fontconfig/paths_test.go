@@ -0,0 +1,111 @@
+package fontconfig
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writeFiles write files, paths relative to dir mapped to their content
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for path, content := range files {
+		path = filepath.Join(dir, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestFontDirs(t *testing.T) {
+	sysroot := t.TempDir()
+	t.Setenv("HOME", "/home/user")
+	t.Setenv("XDG_DATA_HOME", "")
+	t.Setenv("XDG_CONFIG_HOME", "")
+	writeFiles(t, sysroot, map[string]string{
+		"etc/fonts/fonts.conf": `<fontconfig>
+	<dir>/usr/share/fonts</dir>
+	<dir>/usr/local/share/fonts</dir>
+	<dir prefix="xdg">fonts</dir>
+	<dir>~/.fonts</dir>
+	<dir>relative/to/cwd</dir>
+	<dir>/usr/share/fonts</dir>
+	<include ignore_missing="yes">conf.d</include>
+</fontconfig>`,
+		"etc/fonts/conf.d/09-dirs.conf": `<fontconfig>
+	<dir prefix="relative">extra</dir>
+	<remap-dir as-path="/usr/share/fonts">/run/host/fonts</remap-dir>
+	<include ignore_missing="yes" prefix="xdg">fontconfig/fonts.conf</include>
+</fontconfig>`,
+		"home/user/.config/fontconfig/fonts.conf": `<fontconfig><dir>/opt/fonts</dir></fontconfig>`,
+	})
+
+	tests := []struct {
+		userMode bool
+		want     []string
+	}{
+		{false, []string{"/usr/share/fonts", "/usr/local/share/fonts", "/etc/fonts/conf.d/extra", "/run/host/fonts"}},
+		{true, []string{"/usr/share/fonts", "/usr/local/share/fonts", "/home/user/.local/share/fonts", "/home/user/.fonts",
+			"/etc/fonts/conf.d/extra", "/run/host/fonts", "/opt/fonts"}},
+	}
+	for _, tt := range tests {
+		if got := FontDirs(sysroot, tt.userMode); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("user mode %t: got %q, want %q", tt.userMode, got, tt.want)
+		}
+	}
+
+	// without a configuration
+	empty := t.TempDir()
+	if got := FontDirs(empty, false); !reflect.DeepEqual(got, []string{"/usr/share/fonts"}) {
+		t.Errorf("fallback %q", got)
+	}
+	if got := FontDirs(empty, true); !reflect.DeepEqual(got, []string{"/usr/share/fonts", "/home/user/.local/share/fonts"}) {
+		t.Errorf("user fallback %q", got)
+	}
+}
+
+func TestResolveInclude(t *testing.T) {
+	sysroot := t.TempDir()
+	t.Setenv("HOME", "/home/user")
+	t.Setenv("XDG_CONFIG_HOME", "/home/user/cfg")
+	writeFiles(t, sysroot, map[string]string{
+		"etc/fonts/conf.d/20-b.conf":       "",
+		"etc/fonts/conf.d/10-a.conf":       "",
+		"etc/fonts/conf.d/README":          "",
+		"etc/fonts/local.conf":             "",
+		"home/user/cfg/fontconfig/x.conf":  "",
+		"home/user/.fonts.conf":            "",
+		"usr/share/fontconfig/extra.conf":  "",
+		"usr/share/fontconfig/nested.conf": "",
+	})
+	file := filepath.Join(sysroot, "etc/fonts/fonts.conf")
+
+	tests := []struct {
+		inc      Include
+		userMode bool
+		want     []string
+	}{
+		{Include{Path: "conf.d"}, false, []string{"etc/fonts/conf.d/10-a.conf", "etc/fonts/conf.d/20-b.conf"}},
+		{Include{Path: "local.conf"}, false, []string{"etc/fonts/local.conf"}},
+		{Include{Path: "/usr/share/fontconfig/extra.conf"}, false, []string{"usr/share/fontconfig/extra.conf"}},
+		{Include{Path: "missing.conf", IgnoreMissing: "yes"}, false, nil},
+		{Include{Prefix: "xdg", Path: "fontconfig/x.conf"}, false, nil},
+		{Include{Prefix: "xdg", Path: "fontconfig/x.conf"}, true, []string{"home/user/cfg/fontconfig/x.conf"}},
+		{Include{Path: "~/.fonts.conf"}, false, nil},
+		{Include{Path: "~/.fonts.conf"}, true, []string{"home/user/.fonts.conf"}},
+		{Include{Prefix: "relative", Path: "../../usr/share/fontconfig/nested.conf"}, false, []string{"usr/share/fontconfig/nested.conf"}},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, f := range ResolveInclude(tt.inc, file, sysroot, tt.userMode) {
+			rel, _ := filepath.Rel(sysroot, f)
+			got = append(got, rel)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%+v in user mode %t: got %q, want %q", tt.inc, tt.userMode, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package fontconfig
+
+import (
+	"fmt"
+	"strings"
+)
+
+// elements allowed below <fontconfig> besides the typed nodes, from fonts.dtd and the elements
+// fcxml.c still accepts. elements in another namespace, like <its:rules>, are ignored by fontconfig.
+var topElements = []string{"description", "dir", "cachedir", "cache", "config", "remap-dir", "reset-dirs"}
+
+// attribute values allowed by fonts.dtd, the empty string stands for the attribute being omitted
+var (
+	matchTargets  = []string{"", "pattern", "font", "scan"}
+	testQuals     = []string{"", "any", "all", "first", "not_first"}
+	testTargets   = []string{"", "pattern", "font", "scan", "default"}
+	testCompares  = []string{"", "eq", "not_eq", "less", "less_eq", "more", "more_eq", "contains", "not_contains"}
+	editModes     = []string{"", "assign", "assign_replace", "prepend", "prepend_first", "append", "append_last", "delete", "delete_all"}
+	bindings      = []string{"", "weak", "strong", "same"}
+	includeBools  = []string{"", "yes", "no"}
+	includePrefix = []string{"", "default", "xdg", "cwd", "relative"}
+)
+
+// Constants the symbolic names fontconfig knows for <const>, the _FcBaseConstants of fcname.c
+var Constants = []string{
+	// weight
+	"thin", "extralight", "ultralight", "demilight", "semilight", "light", "book", "regular", "normal",
+	"medium", "demibold", "semibold", "bold", "extrabold", "ultrabold", "black", "heavy", "extrablack", "ultrablack",
+	// slant
+	"roman", "italic", "oblique",
+	// width
+	"ultracondensed", "extracondensed", "condensed", "semicondensed", "semiexpanded", "expanded",
+	"extraexpanded", "ultraexpanded",
+	// spacing
+	"proportional", "dual", "mono", "charcell",
+	// rgba
+	"unknown", "rgb", "bgr", "vrgb", "vbgr", "none",
+	// hintstyle
+	"hintnone", "hintslight", "hintmedium", "hintfull",
+	// booleans
+	"antialias", "hinting", "verticallayout", "autohint", "globaladvance", "outline", "scalable",
+	"minspace", "embolden", "embeddedbitmap", "decorative",
+	// lcdfilter
+	"lcdnone", "lcddefault", "lcdlight", "lcdlegacy",
+}
+
+// the number of arguments of every operation, -1 for two or more
+var operations = map[string]int{
+	"plus": -1, "minus": -1, "times": -1, "divide": -1,
+	"or": -1, "and": -1,
+	"eq": 2, "not_eq": 2, "less": 2, "less_eq": 2, "more": 2, "more_eq": 2, "contains": 2, "not_contains": 2,
+	"not": 1, "floor": 1, "ceil": 1, "round": 1, "trunc": 1,
+	"if": 3, "matrix": 4, "range": 2,
+}
+
+// ValidationError an element violating fonts.dtd
+type ValidationError struct {
+	Line    int
+	Element string
+	Reason  string
+}
+
+func (e ValidationError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("line %d: <%s>: %s", e.Line, e.Element, e.Reason)
+	}
+	return fmt.Sprintf("<%s>: %s", e.Element, e.Reason)
+}
+
+// ValidationErrors every violation found in a document
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	var s []string
+	for _, v := range e {
+		s = append(s, v.Error())
+	}
+	return strings.Join(s, "; ")
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// validator collect the violations of a document
+type validator struct {
+	errs ValidationErrors
+}
+
+func (v *validator) errorf(line int, element, format string, a ...interface{}) {
+	v.errs = append(v.errs, ValidationError{line, element, fmt.Sprintf(format, a...)})
+}
+
+// attr check the value of attribute name against allowed
+func (v *validator) attr(line int, element, name, value string, allowed []string) {
+	if !contains(allowed, value) {
+		v.errorf(line, element, "invalid %s %q", name, value)
+	}
+}
+
+// Validate check doc against the semantics of fonts.dtd: allowed elements, attribute values,
+// constant names and operation arguments. returns ValidationErrors or nil.
+func Validate(doc *Document) error {
+	v := validator{}
+	for _, c := range doc.Comments {
+		if _, err := marshalComment(c); err != nil {
+			v.errorf(0, "fontconfig", err.Error())
+		}
+	}
+	for _, n := range doc.Nodes {
+		v.node(n)
+	}
+	if len(v.errs) > 0 {
+		return v.errs
+	}
+	return nil
+}
+
+func (v *validator) node(n Node) {
+	switch t := n.(type) {
+	case Comment:
+		if _, err := marshalComment(string(t)); err != nil {
+			v.errorf(0, "fontconfig", err.Error())
+		}
+	case Match:
+		v.attr(t.Line, "match", "target", t.Target, matchTargets)
+		for _, test := range t.Tests {
+			v.test(test)
+			if t.Target != "font" && t.Target != "scan" && test.Target == "font" {
+				v.errorf(test.Line, "test", "target \"font\" is only valid in a <match target=\"font\">")
+			}
+		}
+		for _, edit := range t.Edits {
+			v.edit(edit)
+		}
+	case Alias:
+		v.attr(t.Line, "alias", "binding", t.Binding, bindings)
+		for _, test := range t.Tests {
+			v.test(test)
+		}
+		if len(t.Family) == 0 {
+			v.errorf(t.Line, "alias", "no <family>")
+		}
+		for _, l := range []*FamilyList{t.Prefer, t.Accept, t.Default} {
+			if l != nil && len(l.Families) == 0 {
+				v.errorf(t.Line, "alias", "empty family list")
+			}
+		}
+	case SelectFont:
+		if t.Accept == nil && t.Reject == nil {
+			v.errorf(t.Line, "selectfont", "no <acceptfont> or <rejectfont>")
+		}
+		for _, fs := range []*FontSet{t.Accept, t.Reject} {
+			if fs == nil {
+				continue
+			}
+			for _, p := range fs.Patterns {
+				for _, elt := range p.Elts {
+					if len(elt.Name) == 0 {
+						v.errorf(t.Line, "patelt", "no name")
+					}
+					if len(elt.Values) != 1 {
+						v.errorf(t.Line, "patelt", "needs exactly one value, has %d", len(elt.Values))
+					}
+					for _, e := range elt.Values {
+						v.expr(t.Line, e)
+					}
+				}
+			}
+		}
+	case Include:
+		v.attr(t.Line, "include", "ignore_missing", t.IgnoreMissing, includeBools)
+		v.attr(t.Line, "include", "prefix", t.Prefix, includePrefix)
+		if len(strings.TrimSpace(t.Path)) == 0 {
+			v.errorf(t.Line, "include", "empty path")
+		}
+	case Element:
+		if len(t.XMLName.Space) == 0 && !contains(topElements, t.XMLName.Local) {
+			v.errorf(t.Line, t.XMLName.Local, "element not allowed in <fontconfig>")
+		}
+	}
+}
+
+func (v *validator) test(t Test) {
+	if len(t.Name) == 0 {
+		v.errorf(t.Line, "test", "no name")
+	}
+	v.attr(t.Line, "test", "qual", t.Qual, testQuals)
+	v.attr(t.Line, "test", "target", t.Target, testTargets)
+	v.attr(t.Line, "test", "compare", t.Compare, testCompares)
+	if len(t.Values) == 0 {
+		v.errorf(t.Line, "test", "no value to compare %s with", t.Name)
+	}
+	for _, e := range t.Values {
+		v.expr(t.Line, e)
+	}
+}
+
+func (v *validator) edit(e Edit) {
+	if len(e.Name) == 0 {
+		v.errorf(e.Line, "edit", "no name")
+	}
+	v.attr(e.Line, "edit", "mode", e.Mode, editModes)
+	v.attr(e.Line, "edit", "binding", e.Binding, bindings)
+	for _, x := range e.Values {
+		v.expr(e.Line, x)
+	}
+}
+
+func (v *validator) expr(line int, e Expr) {
+	switch t := e.(type) {
+	case Const:
+		if !contains(Constants, string(t)) {
+			v.errorf(line, "const", "unknown constant %q", string(t))
+		}
+	case Name:
+		if len(t) == 0 {
+			v.errorf(line, "name", "empty name")
+		}
+	case Charset:
+		for _, r := range t {
+			if r.Min > r.Max || r.Max > 0x10ffff {
+				v.errorf(line, "charset", "invalid range %#x-%#x", r.Min, r.Max)
+			}
+		}
+	case Op:
+		n, ok := operations[t.Name]
+		if !ok {
+			v.errorf(line, t.Name, "unknown expression")
+			return
+		}
+		if (n < 0 && len(t.Args) < 2) || (n > 0 && len(t.Args) != n) {
+			v.errorf(line, t.Name, "wrong number of arguments %d", len(t.Args))
+		}
+		for _, a := range t.Args {
+			v.expr(line, a)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package fontconfig
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateShipped(t *testing.T) {
+	doc, err := Parse(strings.NewReader(shipped))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Validate(doc); err != nil {
+		t.Errorf("shipped configuration rejected: %s", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		// want a substring of the error, empty for a valid document
+		want string
+	}{
+		{"empty match", `<match/>`, ""},
+		{"extrablack", `<match><edit name="weight"><const>extrablack</const></edit></match>`, ""},
+		{"ultrablack", `<match><edit name="weight"><const>ultrablack</const></edit></match>`, ""},
+		{"lcdfilter", `<match target="font"><edit name="lcdfilter"><const>lcdlegacy</const></edit></match>`, ""},
+		{"test target scan", `<match target="scan"><test target="scan" name="family"><string>A</string></test></match>`, ""},
+		{"delete without value", `<match><edit name="family" mode="delete_all"/></match>`, ""},
+		{"namespaced element", `<its:rules xmlns:its="http://www.w3.org/2005/11/its" version="1.0"/>`, ""},
+		{"remap-dir", `<remap-dir as-path="/fonts">/run/fonts</remap-dir>`, ""},
+		{"unknown constant", `<match><edit name="weight"><const>superbold</const></edit></match>`, `unknown constant "superbold"`},
+		{"unknown element", `<foo/>`, "<foo>: element not allowed"},
+		{"test without value", `<match><test name="family"/></match>`, "no value to compare family"},
+		{"font test in pattern match", `<match><test target="font" name="family"><string>A</string></test></match>`, `only valid in a <match target="font">`},
+		{"edit mode", `<match><edit name="family" mode="replace"><string>A</string></edit></match>`, `invalid mode "replace"`},
+		{"alias without family", `<alias><prefer><family>A</family></prefer></alias>`, "no <family>"},
+		{"if arguments", `<match><edit name="dpi"><if><bool>true</bool><int>1</int></if></edit></match>`, "wrong number of arguments 2"},
+		{"include without path", `<include ignore_missing="yes"></include>`, "empty path"},
+	}
+	for _, tt := range tests {
+		doc, err := Parse(strings.NewReader("<fontconfig>\n" + tt.in + "\n</fontconfig>"))
+		if err != nil {
+			t.Fatalf("%s: %s", tt.name, err)
+		}
+		err = Validate(doc)
+		switch {
+		case len(tt.want) == 0 && err != nil:
+			t.Errorf("%s: %s", tt.name, err)
+		case len(tt.want) > 0 && (err == nil || !strings.Contains(err.Error(), tt.want)):
+			t.Errorf("%s: got %v, want %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestValidationErrorLine(t *testing.T) {
+	doc, err := Parse(strings.NewReader("<fontconfig>\n<match>\n<edit name=\"weight\"><const>superbold</const></edit>\n</match>\n</fontconfig>"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	errs, ok := Validate(doc).(ValidationErrors)
+	if !ok || len(errs) != 1 || errs[0].Line != 3 || errs[0].Element != "const" {
+		t.Errorf("got %#v", errs)
+	}
+}
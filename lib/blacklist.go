@@ -2,109 +2,228 @@ package lib
 
 import (
 	"fmt"
-	"log"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/marguerite/fonts-config-ng/charset"
 	ft "github.com/marguerite/fonts-config-ng/font"
+	"github.com/marguerite/fonts-config-ng/fontconfig"
 	"github.com/marguerite/fonts-config-ng/sysconfig"
 )
 
-// getEmojiFonts get all system emoji fonts
-func getEmojiFonts(c ft.Collection) ft.Collection {
-	c1 := ft.Collection{}
-	for _, ft := range c {
-		if ft.IsEmoji() {
-			c1 = append(c1, ft)
-		}
-	}
-	return c1
-}
-
 // Blacklist the font name and blacklisted charset
 type Blacklist struct {
 	Name string
 	charset.Charset
 }
 
-// GenEmojiBlacklist generate 81-emoji-blacklist-glyphs.conf
-// 1. blacklist charsets < 200d in emoji fonts, they are everywhere and non-emoji
+// emojiCutoff the code point the ranges of emoji fonts must reach to be emoji, U+200D ZERO WIDTH JOINER
+const emojiCutoff uint64 = 0x200d
+
+// emojiCharset the characters emoji fonts are used for with EMOJI_CHARSET="unicode": the emoji and the
+// emoji components like ZWJ and variation selectors, but not the ones of Basic Latin and Latin-1
+// Supplement like digits, "#", "©" and "®", they are everywhere and look better in text fonts
+func emojiCharset() charset.Bitmap {
+	var b charset.Bitmap
+	for _, p := range []string{"Emoji", "Extended_Pictographic", "Emoji_Component"} {
+		c, _ := charset.Property(p)
+		b = b.Union(c.Bitmap())
+	}
+	for _, block := range []string{"Basic Latin", "Latin-1 Supplement"} {
+		c, _ := charset.Block(block)
+		b = b.Subtract(c.Bitmap())
+	}
+	return b
+}
+
+// emojiPolicy the EMOJI_* settings of the blacklist
+type emojiPolicy struct {
+	// allow the families never touched
+	allow []string
+	// keep the characters kept per family
+	keep map[string]charset.Bitmap
+	// preferred the emoji family the blacklisted emoji are taken from
+	preferred string
+	// text whether emoji with text presentation by default stay in text fonts
+	text bool
+	// unicode whether the emoji are told by their Unicode properties instead of the cutoff
+	unicode bool
+}
+
+// newEmojiPolicy parse the EMOJI_* settings
+func newEmojiPolicy(cfg sysconfig.Settings) (emojiPolicy, error) {
+	p := emojiPolicy{keep: make(map[string]charset.Bitmap),
+		preferred: strings.TrimSpace(cfg.PreferEmojiFamily),
+		text:      cfg.EmojiPresentation == "text",
+		unicode:   cfg.EmojiCharset == "unicode",
+	}
+	for _, family := range strings.Split(cfg.EmojiBlacklistAllowlist, ":") {
+		if family = strings.TrimSpace(family); len(family) > 0 {
+			p.allow = append(p.allow, family)
+		}
+	}
+	for _, entry := range strings.Split(cfg.EmojiBlacklistKeepRanges, ";") {
+		if len(strings.TrimSpace(entry)) == 0 {
+			continue
+		}
+		arr := strings.SplitN(entry, "=", 2)
+		if len(arr) != 2 || len(strings.TrimSpace(arr[0])) == 0 || !validCharset(arr[1]) {
+			return p, fmt.Errorf("invalid EMOJI_BLACKLIST_KEEP_RANGES entry %q: must be FAMILY=CHARSET", entry)
+		}
+		family := strings.TrimSpace(arr[0])
+		p.keep[family] = p.keep[family].Union(charset.NewCharset(arr[1]).Bitmap())
+	}
+	return p, nil
+}
+
+// validCharset whether s are hexadecimal code points and ascending ranges of Unicode separated by blanks
+func validCharset(s string) bool {
+	fields := strings.Fields(s)
+	for _, field := range fields {
+		bounds := strings.Split(field, "-")
+		if len(bounds) > 2 {
+			return false
+		}
+		var cps []uint64
+		for _, b := range bounds {
+			cp, err := strconv.ParseUint(b, 16, 32)
+			if err != nil || cp > charset.MaxCodePoint {
+				return false
+			}
+			cps = append(cps, cp)
+		}
+		if cps[0] > cps[len(cps)-1] {
+			return false
+		}
+	}
+	return len(fields) > 0
+}
+
+// emoji the characters the emoji font f is used for. by default they are the ranges of its charset
+// reaching U+200D, the ones before are everywhere and non-emoji
+func (p emojiPolicy) emoji(f ft.Font, unicode charset.Bitmap) charset.Bitmap {
+	if p.unicode {
+		return f.Charset.Bitmap().Intersect(unicode)
+	}
+	var c charset.Charset
+	for _, v := range f.Charset {
+		if v.Max >= emojiCutoff {
+			c.Append(v)
+		}
+	}
+	return c.Bitmap()
+}
+
+// blacklist the blacklist of the font, without the characters the policy keeps in it
+func (p emojiPolicy) blacklist(f ft.Font, chars charset.Bitmap) Blacklist {
+	for family, keep := range p.keep {
+		if f.HasFamily(family) {
+			chars = chars.Subtract(keep)
+		}
+	}
+	b := Blacklist{Name: f.Name[0], Charset: chars.Charset()}
+	if len(f.Name) > 1 {
+		b.Name = f.Name[len(f.Name)-1]
+	}
+	return b
+}
+
+// GenEmojiBlacklist generate 81-emoji-blacklist-glyphs.conf according to the EMOJI_* settings
+// 1. blacklist non-emoji charsets in emoji fonts, < 200d unless EMOJI_CHARSET="unicode"
 // 2. blacklist emoji unicode codepoints in other fonts
-func GenEmojiBlacklist(collection ft.Collection, userMode bool, cfg sysconfig.Config) {
-	emojis := getEmojiFonts(collection)
+func GenEmojiBlacklist(w Writer, collection ft.Collection, userMode bool, cfg sysconfig.Settings) error {
+	path := GetFcConfig("blacklist", userMode)
+	if !cfg.EmojiBlacklist {
+		Dbg(cfg.Verbosity, Debug, fmt.Sprintf("emoji blacklist disabled, removing %s", path))
+		return writeFcDocument(w, path, nil)
+	}
 
-	// no emoji fonts on the system
-	if len(emojis) == 0 {
-		return
+	policy, err := newEmojiPolicy(cfg)
+	if err != nil {
+		return err
 	}
 
-	Dbg(cfg.Int("VERBOSITY"), Debug, "blacklisting charsets < 200d in emoji fonts")
+	collection = collection.Filter(func(f ft.Font) bool { return len(f.Name) > 0 && !f.HasFamily(policy.allow...) })
+	emojis := collection.Filter(ft.Font.IsEmoji)
 
-	var emojiConf, nonEmojiConf string
-	var cs charset.Charset
+	// no emoji fonts on the system, a blacklist generated before is stale
+	if len(emojis) == 0 {
+		Dbg(cfg.Verbosity, Debug, fmt.Sprintf("no emoji fonts, removing %s", path))
+		return writeFcDocument(w, path, nil)
+	}
 
-	for _, ft := range emojis {
-		var c, c1 charset.Charset
-
-		// select CharsetRange < 200d
-		for _, v := range ft.Charset {
-			if v.Max < 8205 {
-				c.Append(v)
-			} else {
-				c1.Append(v)
-			}
+	// the emoji other fonts lose come from the preferred emoji family only, if installed
+	sources := emojis
+	if len(policy.preferred) > 0 {
+		if preferred := emojis.Filter(func(f ft.Font) bool { return f.HasFamily(policy.preferred) }); len(preferred) > 0 {
+			sources = preferred
+		} else {
+			Dbg(cfg.Verbosity, Verbose, fmt.Sprintf("WARNING: preferred emoji family %s is not installed, using every emoji font.", policy.preferred))
+			policy.preferred = ""
 		}
+	}
+
+	Dbg(cfg.Verbosity, Debug, "blacklisting non-emoji charsets in emoji fonts")
 
-		cs = cs.Union(c1)
+	doc := newFcDocument(userMode)
+	var unicode charset.Bitmap
+	if policy.unicode {
+		unicode = emojiCharset()
+	}
 
+	for _, ft := range emojis {
 		// black'em
-		if len(c) > 0 {
-			b := Blacklist{}
-			b.Name = ft.Name[0]
-			if len(ft.Name) > 1 {
-				b.Name = ft.Name[len(ft.Name)-1]
-			}
-			b.Charset = c
-			emojiConf += genBlacklistConfig(b)
+		b := policy.blacklist(ft, ft.Charset.Bitmap().Subtract(policy.emoji(ft, unicode)))
+		if len(b.Charset) > 0 {
+			doc.Append(genBlacklistConfig(b))
 		}
 	}
 
-	Dbg(cfg.Int("VERBOSITY"), Debug, "blacklisting emoji glyphs from non-emoji fonts")
+	var cs charset.Bitmap
+	for _, ft := range sources {
+		cs = cs.Union(policy.emoji(ft, unicode))
+	}
+	if policy.text {
+		// emoji with text presentation by default stay in text fonts
+		all, _ := charset.Property("Emoji")
+		presentation, _ := charset.Property("Emoji_Presentation")
+		cs = cs.Subtract(all.Bitmap().Subtract(presentation.Bitmap()))
+	}
+
+	Dbg(cfg.Verbosity, Debug, "blacklisting emoji glyphs from non-emoji fonts")
 
 	wg := sync.WaitGroup{}
-	wg.Add(len(collection) - len(emojis))
-	mux := sync.Mutex{}
+	// keep the order of collection, so the generated file is stable between runs
+	nonEmoji := make([]*fontconfig.Match, len(collection))
 
-	for _, font := range collection {
+	for i, font := range collection {
 		if !font.IsEmoji() {
-			go func(f ft.Font, verbosity int) {
+			wg.Add(1)
+			go func(i int, f ft.Font, verbosity int) {
 				defer wg.Done()
-				in := f.Charset.Intersect(cs)
-
-				if len(in) > 0 {
-					b := Blacklist{}
-					b.Charset = in
-					b.Name = f.Name[0]
-					if len(f.Name) > 1 {
-						b.Name = f.Name[len(f.Name)-1]
-					}
+				b := policy.blacklist(f, f.Charset.Bitmap().Intersect(cs))
 
+				if len(b.Charset) > 0 {
 					Dbg(verbosity, Debug, fmt.Sprintf("Processing font %s with intersected charset: %s", b.Name, b.Charset.String()))
-					mux.Lock()
-					nonEmojiConf += genBlacklistConfig(b)
-					mux.Unlock()
+					m := genBlacklistConfig(b)
+					nonEmoji[i] = &m
 				}
-			}(font, cfg.Int("VERBOSITY"))
+			}(i, font, cfg.Verbosity)
 		}
 	}
 
 	wg.Wait()
 
-	conf := genFcPreamble(userMode, "") + emojiConf + nonEmojiConf + FcSuffix
-	blacklist := GetFcConfig("blacklist", userMode)
-	err := overwriteOrRemoveFile(blacklist, []byte(conf))
+	for _, m := range nonEmoji {
+		if m != nil {
+			doc.Append(*m)
+		}
+	}
 
-	if err != nil {
-		log.Fatalf("Can not write %s: %s\n", blacklist, err.Error())
+	if len(policy.preferred) > 0 {
+		doc.Append(fontconfig.Alias{Family: []string{"emoji"}, Prefer: fontconfig.NewFamilyList(policy.preferred)})
 	}
+
+	return writeFcDocument(w, path, doc)
 }
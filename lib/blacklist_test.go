@@ -0,0 +1,161 @@
+package lib
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/marguerite/fonts-config-ng/charset"
+	ft "github.com/marguerite/fonts-config-ng/font"
+	"github.com/marguerite/fonts-config-ng/fontconfig"
+	"github.com/marguerite/fonts-config-ng/sysconfig"
+)
+
+func TestNewEmojiPolicy(t *testing.T) {
+	p, err := newEmojiPolicy(sysconfig.Settings{
+		PreferEmojiFamily:        " Noto Color Emoji ",
+		EmojiBlacklistAllowlist:  "Symbola: DejaVu Sans::",
+		EmojiBlacklistKeepRanges: "DejaVu Sans=2190-21ff; Hack=2600 ;DejaVu Sans=2700-27bf;",
+		EmojiPresentation:        "text",
+		EmojiCharset:             "unicode",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.preferred != "Noto Color Emoji" || !p.text || !p.unicode {
+		t.Errorf("policy %+v", p)
+	}
+	if !reflect.DeepEqual(p.allow, []string{"Symbola", "DejaVu Sans"}) {
+		t.Errorf("allow %q", p.allow)
+	}
+	keep := map[string]string{}
+	for family, b := range p.keep {
+		keep[family] = b.Charset().String()
+	}
+	if want := map[string]string{"DejaVu Sans": "2190-21ff 2700-27bf ", "Hack": "2600 "}; !reflect.DeepEqual(keep, want) {
+		t.Errorf("keep %q, want %q", keep, want)
+	}
+
+	if p, err := newEmojiPolicy(sysconfig.Settings{EmojiPresentation: "emoji", EmojiCharset: "cutoff"}); err != nil || p.text || p.unicode || len(p.allow) > 0 || len(p.keep) > 0 {
+		t.Errorf("default policy %+v, %v", p, err)
+	}
+
+	for _, keep := range []string{
+		"DejaVu Sans",
+		"=2190-21ff",
+		"DejaVu Sans=",
+		"DejaVu Sans=zz",
+		"DejaVu Sans=1-2-3",
+		// descending
+		"DejaVu Sans=21ff-2190",
+		// beyond Unicode
+		"DejaVu Sans=110000",
+		"DejaVu Sans=0-ffffffff",
+	} {
+		if _, err := newEmojiPolicy(sysconfig.Settings{EmojiBlacklistKeepRanges: keep}); err == nil {
+			t.Errorf("no error for EMOJI_BLACKLIST_KEEP_RANGES=%q", keep)
+		}
+	}
+}
+
+// emojiFonts an emoji font with digits and "©", a text font and a symbol font with emoji
+var emojiFonts = ft.Collection{
+	{Name: []string{"Noto Color Emoji"}, Lang: []string{"und-zsye"}, Charset: charset.NewCharset("23 30-39 a9 2705 2764 1f600")},
+	{Name: []string{"DejaVu Sans"}, Lang: []string{"en"}, Charset: charset.NewCharset("20-7e a9 2705 2764 1f600")},
+	{Name: []string{"Symbola"}, Lang: []string{"en"}, Charset: charset.NewCharset("20-7e 2705 2764 1f600")},
+}
+
+// cjkEmojiFonts an emoji font and a text font sharing non-emoji characters after U+200D
+var cjkEmojiFonts = ft.Collection{
+	{Name: []string{"Noto Color Emoji"}, Lang: []string{"und-zsye"}, Charset: charset.NewCharset("30-39 2014 4e00 1f600")},
+	{Name: []string{"WenQuanYi Zen Hei"}, Lang: []string{"zh-cn"}, Charset: charset.NewCharset("20-7e 2014 4e00 1f600")},
+}
+
+// blacklists the charsets the generated configuration removes, by family
+func blacklists(t *testing.T, b []byte) map[string]string {
+	doc, err := fontconfig.Parse(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := map[string]string{}
+	for _, n := range doc.Nodes {
+		match, ok := n.(fontconfig.Match)
+		if !ok {
+			continue
+		}
+		family := match.Tests[0].Values[0].(fontconfig.String)
+		c := match.Edits[0].Values[0].(fontconfig.Op).Args[1].(fontconfig.Charset)
+		m[string(family)] = strings.TrimSpace(charset.Charset(c).String())
+	}
+	return m
+}
+
+func TestGenEmojiBlacklist(t *testing.T) {
+	tests := []struct {
+		name  string
+		fonts ft.Collection
+		cfg   sysconfig.Settings
+		want  map[string]string
+	}{
+		{"everything goes to emoji", emojiFonts,
+			sysconfig.Settings{EmojiBlacklist: true, EmojiPresentation: "emoji"},
+			map[string]string{"Noto Color Emoji": "23 30-39 a9", "DejaVu Sans": "2705 2764 1f600", "Symbola": "2705 2764 1f600"}},
+		// U+2764 HEAVY BLACK HEART has text presentation by default, U+2705 does not
+		{"text presentation stays in text fonts", emojiFonts,
+			sysconfig.Settings{EmojiBlacklist: true, EmojiPresentation: "text"},
+			map[string]string{"Noto Color Emoji": "23 30-39 a9", "DejaVu Sans": "2705 1f600", "Symbola": "2705 1f600"}},
+		{"allowlist and keep ranges", emojiFonts,
+			sysconfig.Settings{EmojiBlacklist: true, EmojiPresentation: "emoji", EmojiBlacklistAllowlist: "Symbola",
+				EmojiBlacklistKeepRanges: "DejaVu Sans=2700-27bf;Noto Color Emoji=a9"},
+			map[string]string{"Noto Color Emoji": "23 30-39", "DejaVu Sans": "1f600"}},
+		// the emoji of the preferred family only
+		{"preferred family", append(ft.Collection{{Name: []string{"Twemoji"}, Lang: []string{"und-zsye"}, Charset: charset.NewCharset("1f600")}}, emojiFonts...),
+			sysconfig.Settings{EmojiBlacklist: true, EmojiPresentation: "emoji", PreferEmojiFamily: "twemoji"},
+			map[string]string{"Noto Color Emoji": "23 30-39 a9", "DejaVu Sans": "1f600", "Symbola": "1f600"}},
+		// the ranges reaching U+200D are emoji, like the em dash and the CJK ideograph of the emoji font
+		{"cutoff", cjkEmojiFonts,
+			sysconfig.Settings{EmojiBlacklist: true, EmojiPresentation: "emoji", EmojiCharset: "cutoff"},
+			map[string]string{"Noto Color Emoji": "30-39", "WenQuanYi Zen Hei": "2014 4e00 1f600"}},
+		{"unicode", cjkEmojiFonts,
+			sysconfig.Settings{EmojiBlacklist: true, EmojiPresentation: "emoji", EmojiCharset: "unicode"},
+			map[string]string{"Noto Color Emoji": "30-39 2014 4e00", "WenQuanYi Zen Hei": "1f600"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setTestRoot(t, nil)
+			w := NewMemoryWriter()
+			if err := GenEmojiBlacklist(w, tt.fonts, false, tt.cfg); err != nil {
+				t.Fatal(err)
+			}
+			b := w.Files[GetFcConfig("blacklist", false)]
+			if got := blacklists(t, b); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("blacklists %q, want %q", got, tt.want)
+			}
+			if prefer := bytes.Contains(b, []byte("<family>emoji</family>")); prefer != (len(tt.cfg.PreferEmojiFamily) > 0) {
+				t.Errorf("preferred emoji family alias: %t", prefer)
+			}
+		})
+	}
+}
+
+func TestGenEmojiBlacklistRemoved(t *testing.T) {
+	for name, tt := range map[string]struct {
+		fonts ft.Collection
+		cfg   sysconfig.Settings
+	}{
+		"disabled":       {emojiFonts, sysconfig.Settings{}},
+		"no emoji fonts": {emojiFonts[1:], sysconfig.Settings{EmojiBlacklist: true, EmojiPresentation: "emoji"}},
+		"only allowed emoji fonts": {emojiFonts, sysconfig.Settings{EmojiBlacklist: true, EmojiPresentation: "emoji",
+			EmojiBlacklistAllowlist: "Noto Color Emoji"}},
+	} {
+		setTestRoot(t, nil)
+		w := NewMemoryWriter()
+		if err := GenEmojiBlacklist(w, tt.fonts, false, tt.cfg); err != nil {
+			t.Fatal(err)
+		}
+		if b, ok := w.Files[GetFcConfig("blacklist", false)]; !ok || len(b) > 0 {
+			t.Errorf("%s: the blacklist is not removed: %q", name, b)
+		}
+	}
+}
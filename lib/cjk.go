@@ -1,21 +1,20 @@
 package lib
 
 import (
+	"sort"
 	"strings"
 
 	ft "github.com/marguerite/fonts-config-ng/font"
-	"github.com/marguerite/go-stdlib/slice"
+	"github.com/marguerite/fonts-config-ng/fontconfig"
 )
 
 // GenCJKConfig generate cjk specific fontconfig configuration like
 // special matrix adjustment for "Noto Sans/Serif", dual-width Asian fonts and etc.
-func GenCJKConfig(c ft.Collection, userMode bool) {
-	conf := GetFcConfig("cjk", userMode)
-	text := genFcPreamble(userMode, "")
-	text += fixDualAsianFonts(c)
-	text += genNotoCJK()
-	text += FcSuffix
-	overwriteOrRemoveFile(conf, []byte(text))
+func GenCJKConfig(w Writer, c ft.Collection, userMode bool) error {
+	doc := newFcDocument(userMode)
+	doc.Append(fixDualAsianFonts(c)...)
+	doc.Append(genNotoCJK()...)
+	return writeFcDocument(w, GetFcConfig("cjk", userMode), doc)
 }
 
 // isSpacingDual find spacing=dual/mono/charcell
@@ -29,70 +28,59 @@ func isSpacingDual(font ft.Font) int {
 	return -1
 }
 
-// isCJKFont find if a font supports CJK
-func isCJKFont(font ft.Font) bool {
-	supportedLangs := []string{"zh", "ja", "ko", "zh-cn", "zh-tw", "zh-hk", "zh-mo", "zh-sg"}
-	if ok, err := slice.Contains(font.Lang, supportedLangs); ok && err == nil {
-		return true
-	}
-	return false
-}
-
 // fixDualAsianFonts fix rendering of dual-width Asian fonts (spacing=dual)
-func fixDualAsianFonts(c ft.Collection) string {
-	comment := "<!-- The dual-width Asian fonts (spacing=dual) are not rendered correctly," +
-		"apparently FreeType forces all widths to match.\n" +
-		"Trying to disable the width forcing code by setting globaladvance=false alone doesn't help.\n" +
-		"As a brute force workaround, also set spacing=proportional, i.e. handle them as proportional fonts. -->\n" +
-		"<!-- There is a similar problem with dual width bitmap fonts which don't have spacing=dual but mono or charcell.-->\n\n"
-	text := ""
+func fixDualAsianFonts(c ft.Collection) []fontconfig.Node {
+	comments := []fontconfig.Node{
+		fontconfig.Comment(" The dual-width Asian fonts (spacing=dual) are not rendered correctly," +
+			"apparently FreeType forces all widths to match.\n" +
+			"Trying to disable the width forcing code by setting globaladvance=false alone doesn't help.\n" +
+			"As a brute force workaround, also set spacing=proportional, i.e. handle them as proportional fonts. "),
+		fontconfig.Comment(" There is a similar problem with dual width bitmap fonts which don't have spacing=dual but mono or charcell."),
+	}
+	var nodes []fontconfig.Node
 
-	for _, font := range c {
-		if isSpacingDual(font) >= 0 && isCJKFont(font) {
-			text += genDualAisanConfig(font)
+	for _, font := range c.ByLang("zh", "ja", "ko") {
+		if isSpacingDual(font) >= 0 {
+			nodes = append(nodes, genDualAisanConfig(font)...)
 		}
 	}
 
-	if len(text) > 0 {
-		return comment + text
+	if len(nodes) > 0 {
+		return append(comments, nodes...)
 	}
-	return text
+	return nodes
 }
 
-func ppd(generic, lang string) string {
+func ppd(generic, lang string) []string {
 	if lang != "ja" {
-		return ""
+		return nil
 	}
 	m := map[string][]string{"Sans": {"IPAPGothic", "IPAexGothic", "M+ 1c", "M+ 1p", "VL PGothic"}, "Serif": {"IPAPMincho", "IPAexMincho"}, "monospace": {"IPAGothic", "M+ 1m", "VL Gothic"}}
-	var str string
-	for _, v := range m[generic] {
-		str += "\t\t\t<string>" + v + "</string>\n"
-	}
-	return str
+	return m[generic]
 }
 
-func apd(generic, lang string) string {
+func apd(generic, lang string) []string {
 	switch lang {
 	case "ja":
 		m := map[string]string{"Sans": "IPAGothic", "Serif": "IPAMincho"}
 		if val, ok := m[generic]; ok {
-			return "\t\t\t<string>" + val + "</string>\n"
+			return []string{val}
 		}
-		return ""
+		return nil
 	case "ko":
 		m := map[string]string{"Sans": "NanumGothic", "Serif": "NanumMyeongjo", "monospace": "NanumGothicCoding"}
-		return "\t\t\t<string>" + m[generic] + "</string>\n"
+		return []string{m[generic]}
 	case "zh-tw", "zh-hk", "zh-mo":
 		if generic == "Serif" {
-			return "\t\t\t<string>CMEXSong</string>\n"
+			return []string{"CMEXSong"}
 		}
-		return ""
+		return nil
 	default:
-		return ""
+		return nil
 	}
 }
 
-func genNotoCJK() string {
+func genNotoCJK() []fontconfig.Node {
 	order := map[string][]string{"zh-cn": {"SC", "HK", "TW", "JP", "KR"},
 		"zh-tw": {"TC", "HK", "SC", "JP", "KR"},
 		"zh-hk": {"HK", "TC", "SC", "JP", "KR"},
@@ -101,7 +89,7 @@ func genNotoCJK() string {
 		"ja":    {"JP", "KR", "HK", "TW", "SC"},
 		"ko":    {"KR", "JP", "HK", "TW", "SC"}}
 
-	str := `<!--
+	nodes := []fontconfig.Node{fontconfig.Comment(`
    Currently we use region-specific Subset OpenType/CFF (Subset OTF)
    flavor of Google's Noto Sans/Serif CJK fonts, but previously we
    used Super OpenType/CFF Collection (Super OTC), and other distributions
@@ -125,49 +113,57 @@ func genNotoCJK() string {
       Adobe's Source Code Pro which is openSUSE's choice for
       Monospace font.
    3. The 'Noto Sans Mono CJK XX' are real fonts in openSUSE.
--->` + "\n"
+`)}
+
+	// iterate langs in a stable order, so the generated file is stable between runs
+	langs := make([]string, 0, len(order))
+	for k := range order {
+		langs = append(langs, k)
+	}
+	sort.Strings(langs)
 
 	for _, v := range []string{"sans-serif", "serif"} {
-		for k, v1 := range order {
-			str += "\t<match>\n\t\t<test name=\"family\">\n\t\t\t<string>" + v + "</string>\n\t\t</test>\n"
+		for _, k := range langs {
+			v1 := order[k]
 			v3 := v
 			if v3 == "sans-serif" {
 				v3 = v3[:4]
 			}
 			v3 = strings.Title(v3)
-			str += "\t\t<test name=\"lang\">\n\t\t\t<string>" + k + "</string>\n\t\t</test>\n" +
-				"\t\t<edit name=\"family\" mode=\"prepend\">\n" +
-				ppd(v, k)
+			families := ppd(v, k)
 			if v == "sans-serif" {
-				str += "\t\t\t<string>Noto " + v3 + "</string>\n"
+				families = append(families, "Noto "+v3)
 			}
 			for _, v2 := range v1 {
-				str += "\t\t\t<string>Noto " + v3 + " " + v2 + "</string>\n"
+				families = append(families, "Noto "+v3+" "+v2)
 			}
 			if k == "zh-mo" {
-				str += "\t\t\t<string>Noto " + v3 + " CJK HK</string>\n"
+				families = append(families, "Noto "+v3+" CJK HK")
 			} else {
-				str += "\t\t\t<string>Noto " + v3 + " CJK " + v1[0] + "</string>\n"
+				families = append(families, "Noto "+v3+" CJK "+v1[0])
 			}
-			str += apd(v, k)
-			str += "\t\t</edit>\n\t</match>\n\n"
+			families = append(families, apd(v, k)...)
+			nodes = append(nodes, fontconfig.Match{
+				Tests: []fontconfig.Test{familyTest(v, ""), langTest(k, "")},
+				Edits: []fontconfig.Edit{familyEdit("prepend", "", families...)},
+			})
 		}
 	}
 
-	for k, v := range order {
-		str += "\t<match>\n\t\t<test name=\"family\">\n\t\t\t<string>monospace</string>\n\t\t</test>\n" +
-			"\t\t<test name=\"lang\">\n\t\t\t<string>" + k + "</string>\n\t\t</test>\n" +
-			"\t\t<edit name=\"family\" mode=\"prepend\">\n" +
-			ppd("monospace", k) +
-			"\t\t\t<string>Noto Sans CJK "
+	for _, k := range langs {
+		v := order[k]
+		families := ppd("monospace", k)
 		if k == "zh-mo" {
-			str += "HK"
+			families = append(families, "Noto Sans CJK HK")
 		} else {
-			str += v[0]
+			families = append(families, "Noto Sans CJK "+v[0])
 		}
-		str += "</string>\n" + apd("monospace", k)
-		str += "\t\t</edit>\n\t</match>\n\n"
+		families = append(families, apd("monospace", k)...)
+		nodes = append(nodes, fontconfig.Match{
+			Tests: []fontconfig.Test{familyTest("monospace", ""), langTest(k, "")},
+			Edits: []fontconfig.Edit{familyEdit("prepend", "", families...)},
+		})
 	}
 
-	return str
+	return nodes
 }
@@ -2,6 +2,7 @@ package lib
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -15,10 +16,51 @@ const (
 	Verbose int = 1
 	// Quiet echo nothing to output
 	Quiet int = 0
-	// FcSuffix suffix for every fontconfig configuration file
-	FcSuffix string = "</fontconfig>\n"
 )
 
+// root the alternate root directory every file is read from and written to, eg. an OS image being built
+var root = "/"
+
+// rootSet whether SetRoot was called
+var rootSet bool
+
+// SetRoot set the alternate root directory, once, before anything is read or written
+func SetRoot(dir string) error {
+	if rootSet {
+		return fmt.Errorf("can not set root to %s: already set to %s", dir, root)
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	root, rootSet = abs, true
+	return nil
+}
+
+// Root the alternate root directory, "/" unless set by SetRoot
+func Root() string {
+	return root
+}
+
+// RootPath return path relative to Root
+func RootPath(path string) string {
+	return filepath.Join(root, path)
+}
+
+// configHome the user's configuration directory, $XDG_CONFIG_HOME or ~/.config
+func configHome() string {
+	config := os.Getenv("XDG_CONFIG_HOME")
+	if len(config) == 0 {
+		config = filepath.Join(os.Getenv("HOME"), ".config")
+	}
+	return config
+}
+
+// UserFcConfigDir the directory of the user's fontconfig configuration, relative to Root
+func UserFcConfigDir() string {
+	return RootPath(filepath.Join(configHome(), "fontconfig"))
+}
+
 // Dbg if dbgLevel >= limit, return the dbgOut. dbgOut can be plain string or func to format debug information by yourself
 func Dbg(verbosity int, level int, dbgOut interface{}, parms ...interface{}) {
 	if verbosity >= level {
@@ -52,34 +94,106 @@ func GetFcConfig(c string, userMode bool) string {
 	}
 
 	if userMode {
-		return filepath.Join(os.Getenv("HOME"), ".config/fontconfig", m[c][1])
+		return filepath.Join(UserFcConfigDir(), m[c][1])
 	}
 
 	prefix := "/etc/sysconfig"
 	if strings.HasSuffix(m[c][0], ".conf") {
 		prefix = "/etc/fonts/conf.d"
 	}
-	return filepath.Join(prefix, m[c][0])
+	return RootPath(filepath.Join(prefix, m[c][0]))
 }
 
-// overwriteOrRemoveFile Overwrite file with new content or completely remove the file.
-func overwriteOrRemoveFile(path string, content []byte) error {
-	os.Remove(path)
-	if len(content) == 0 {
-		return nil
+// fileMeta the metadata of a file kept when it is overwritten
+type fileMeta struct {
+	exists bool
+	mode   os.FileMode
+	uid    int
+	gid    int
+	label  []byte
+	link   string
+}
+
+// statFile get the metadata of path. a missing or non-regular file gets the default mode 0644.
+// a symlink is not followed.
+func statFile(path string) (fileMeta, error) {
+	meta := fileMeta{mode: 0644, uid: -1, gid: -1}
+	info, err := os.Lstat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return meta, nil
+		}
+		return meta, err
 	}
-	f, err := os.Create(path)
+	meta.exists = true
+	if info.Mode()&os.ModeSymlink != 0 {
+		meta.link, err = os.Readlink(path)
+		return meta, err
+	}
+	if !info.Mode().IsRegular() {
+		return meta, nil
+	}
+	meta.mode = info.Mode().Perm()
+	meta.uid, meta.gid = fileOwner(info)
+	meta.label = getSecurityLabel(path)
+	return meta, nil
+}
+
+// writeFileAtomic write content to a temporary file in the same directory and rename it to path,
+// so path is either the old or the new file, never a partial one.
+func writeFileAtomic(path string, content []byte, meta fileMeta) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".")
 	if err != nil {
 		return err
 	}
-	defer f.Close()
+	defer os.Remove(tmp.Name())
 
-	n, err := f.Write(content)
-	if n != len(content) {
-		return fmt.Errorf("not fully written")
+	_, err = tmp.Write(content)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if err1 := tmp.Close(); err == nil {
+		err = err1
 	}
 	if err != nil {
 		return err
 	}
-	return nil
+
+	err = os.Chmod(tmp.Name(), meta.mode)
+	if err != nil {
+		return err
+	}
+	if meta.uid >= 0 {
+		// only root can give files away, keep whatever we got otherwise
+		os.Lchown(tmp.Name(), meta.uid, meta.gid)
+	}
+	if len(meta.label) > 0 {
+		setSecurityLabel(tmp.Name(), meta.label)
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// overwriteOrRemoveFile Atomically overwrite file with new content or completely remove the file.
+// the mode, owner and SELinux label of the existing file are kept. a symlink at path, eg. into
+// conf.avail, is replaced by a regular file, the file it points to is never touched.
+func overwriteOrRemoveFile(path string, content []byte) error {
+	if len(content) == 0 {
+		err := os.Remove(path)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	meta, err := statFile(path)
+	if err != nil {
+		return err
+	}
+	if !meta.exists {
+		err = os.MkdirAll(filepath.Dir(path), 0755)
+		if err != nil {
+			return err
+		}
+	}
+	return writeFileAtomic(path, content, meta)
 }
@@ -0,0 +1,23 @@
+package lib
+
+import "testing"
+
+func TestGetFcConfig(t *testing.T) {
+	setTestRoot(t, nil)
+	tests := []struct {
+		xdg      string
+		userMode bool
+		want     string
+	}{
+		{"", false, "/etc/fonts/conf.d/10-rendering-options.conf"},
+		{"/home/user/cfg", false, "/etc/fonts/conf.d/10-rendering-options.conf"},
+		{"", true, "/home/user/.config/fontconfig/rendering-options.conf"},
+		{"/home/user/cfg", true, "/home/user/cfg/fontconfig/rendering-options.conf"},
+	}
+	for _, tt := range tests {
+		t.Setenv("XDG_CONFIG_HOME", tt.xdg)
+		if got := GetFcConfig("render", tt.userMode); got != RootPath(tt.want) {
+			t.Errorf("XDG_CONFIG_HOME=%q, user mode %t: got %s, want %s", tt.xdg, tt.userMode, got, RootPath(tt.want))
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package lib
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/marguerite/fonts-config-ng/charset"
+	ft "github.com/marguerite/fonts-config-ng/font"
+)
+
+// FamilyCoverage how many characters of a language or script a font family has
+type FamilyCoverage struct {
+	Family  string `json:"family"`
+	Covered int    `json:"covered"`
+}
+
+// Coverage the font families covering a language or a script
+type Coverage struct {
+	// Kind "lang" or "script"
+	Kind    string           `json:"kind"`
+	Name    string           `json:"name"`
+	Total   int              `json:"total"`
+	Full    []string         `json:"full"`
+	Partial []FamilyCoverage `json:"partial"`
+}
+
+// Status "full" when a family covers it all, "partial" when families cover some characters,
+// "none" when no font has any, the text will be tofu
+func (c Coverage) Status() string {
+	switch {
+	case len(c.Full) > 0:
+		return "full"
+	case len(c.Partial) > 0:
+		return "partial"
+	}
+	return "none"
+}
+
+// newCoverage find the families of fonts having characters of want, faces of a family
+// count with their best coverage
+func newCoverage(kind, name string, want charset.Bitmap, fonts []ft.Font, bitmaps []charset.Bitmap) Coverage {
+	cov := Coverage{Kind: kind, Name: name, Total: want.Count(), Full: []string{}, Partial: []FamilyCoverage{}}
+	best := make(map[string]int)
+	for i, f := range fonts {
+		if len(f.Name) == 0 {
+			continue
+		}
+		if n := bitmaps[i].Intersect(want).Count(); n > best[f.Name[0]] {
+			best[f.Name[0]] = n
+		}
+	}
+	for family, n := range best {
+		if n == cov.Total {
+			cov.Full = append(cov.Full, family)
+			continue
+		}
+		cov.Partial = append(cov.Partial, FamilyCoverage{family, n})
+	}
+	sort.Strings(cov.Full)
+	sort.Slice(cov.Partial, func(i, j int) bool {
+		if cov.Partial[i].Covered != cov.Partial[j].Covered {
+			return cov.Partial[i].Covered > cov.Partial[j].Covered
+		}
+		return cov.Partial[i].Family < cov.Partial[j].Family
+	})
+	return cov
+}
+
+// NewCoverage the coverage of langs and scripts by the fonts of c, every language tag of fontconfig's
+// orthographies and every Unicode script when both are empty. a family covers a language fully when
+// no character of its orthography is missing, like fontconfig decides. Common and Inherited are no
+// writing systems of their own and only checked on request.
+func NewCoverage(c ft.Collection, langs, scripts []string) ([]Coverage, error) {
+	if len(langs) == 0 && len(scripts) == 0 {
+		langs = ft.Langs()
+		for _, s := range charset.Scripts() {
+			if s != "Common" && s != "Inherited" {
+				scripts = append(scripts, s)
+			}
+		}
+	}
+
+	bitmaps := make([]charset.Bitmap, len(c))
+	for i, f := range c {
+		bitmaps[i] = f.Charset.Bitmap()
+	}
+
+	var coverage []Coverage
+	for _, lang := range langs {
+		want, ok := ft.LangCharset(lang)
+		if !ok {
+			return nil, fmt.Errorf("unknown language %s: must be one of %s", lang, strings.Join(ft.Langs(), ", "))
+		}
+		coverage = append(coverage, newCoverage("lang", lang, want.Bitmap(), c, bitmaps))
+	}
+	for _, script := range scripts {
+		want, ok := charset.Script(script)
+		if !ok {
+			return nil, fmt.Errorf("unknown Unicode script %s", script)
+		}
+		coverage = append(coverage, newCoverage("script", script, want.Bitmap(), c, bitmaps))
+	}
+	return coverage, nil
+}
+
+// partialString the partial coverage like "DejaVu Sans (45.2%)"
+func (c Coverage) partialString() string {
+	var s []string
+	for _, p := range c.Partial {
+		s = append(s, fmt.Sprintf("%s (%.1f%%)", p.Family, 100*float64(p.Covered)/float64(c.Total)))
+	}
+	return strings.Join(s, ", ")
+}
+
+// WriteCoverage print the coverage to out in format, one of ListFormats. the table ends with
+// the scripts no font has any character of.
+func WriteCoverage(coverage []Coverage, format string, out io.Writer) error {
+	header := []string{"KIND", "NAME", "STATUS", "CHARS", "FULL", "PARTIAL"}
+
+	switch format {
+	case "json":
+		if coverage == nil {
+			coverage = []Coverage{}
+		}
+		b, err := json.MarshalIndent(coverage, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(b))
+		return err
+	case "csv":
+		w := csv.NewWriter(out)
+		w.Write(header)
+		for _, c := range coverage {
+			w.Write([]string{c.Kind, c.Name, c.Status(), strconv.Itoa(c.Total), strings.Join(c.Full, "|"), c.partialString()})
+		}
+		w.Flush()
+		return w.Error()
+	case "table":
+		w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(w, strings.Join(header, "\t"))
+		var missing []string
+		for _, c := range coverage {
+			fmt.Fprintln(w, strings.Join([]string{c.Kind, c.Name, c.Status(), strconv.Itoa(c.Total), strings.Join(c.Full, ", "), c.partialString()}, "\t"))
+			if c.Status() == "none" && c.Kind == "script" {
+				missing = append(missing, c.Name)
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		if len(missing) > 0 {
+			_, err := fmt.Fprintf(out, "\nscripts without any font: %s\n", strings.Join(missing, ", "))
+			return err
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown format %s: must be one of %s", format, strings.Join(ListFormats, ", "))
+}
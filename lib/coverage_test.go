@@ -0,0 +1,70 @@
+package lib
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/marguerite/fonts-config-ng/charset"
+	ft "github.com/marguerite/fonts-config-ng/font"
+)
+
+func TestNewCoverageLangs(t *testing.T) {
+	coverage, err := NewCoverage(nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	langs := make(map[string]bool)
+	for _, c := range coverage {
+		if c.Kind == "lang" {
+			langs[c.Name] = true
+		}
+		if c.Status() != "none" {
+			t.Errorf("%s %s covered without fonts", c.Kind, c.Name)
+		}
+	}
+	if len(langs) != len(ft.Langs()) {
+		t.Errorf("%d languages, want the %d of fontconfig", len(langs), len(ft.Langs()))
+	}
+	for _, lang := range []string{"en", "th", "hi", "am", "si", "km", "zh-tw", "und-zsye"} {
+		if !langs[lang] {
+			t.Errorf("no coverage of %s", lang)
+		}
+	}
+}
+
+func TestNewCoverage(t *testing.T) {
+	th, _ := ft.LangCharset("th")
+	short := charset.Charset(th).Subtract(charset.NewCharset("e01"))
+	fonts := ft.Collection{
+		{Name: []string{"Thai Full"}, Charset: th},
+		{Name: []string{"Thai Short"}, Charset: short},
+		{Name: []string{"Thai Short"}, Charset: charset.NewCharset("e01-e10")},
+		{Name: []string{"Latin"}, Charset: charset.NewCharset("20-7e")},
+	}
+	coverage, err := NewCoverage(fonts, []string{"th", "am"}, []string{"Thai"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(coverage) != 3 {
+		t.Fatalf("%d coverages", len(coverage))
+	}
+
+	want := Coverage{Kind: "lang", Name: "th", Total: th.Count(), Full: []string{"Thai Full"},
+		Partial: []FamilyCoverage{{"Thai Short", th.Count() - 1}}}
+	if !reflect.DeepEqual(coverage[0], want) {
+		t.Errorf("th %+v, want %+v", coverage[0], want)
+	}
+	if s := coverage[1].Status(); s != "none" {
+		t.Errorf("am %s", s)
+	}
+	if s := coverage[2]; s.Kind != "script" || s.Status() != "partial" {
+		t.Errorf("Thai %+v", s)
+	}
+
+	if _, err := NewCoverage(fonts, []string{"tlh"}, nil); err == nil {
+		t.Error("no error for an unknown language")
+	}
+	if _, err := NewCoverage(fonts, nil, []string{"Klingon"}); err == nil {
+		t.Error("no error for an unknown script")
+	}
+}
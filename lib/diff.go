@@ -0,0 +1,234 @@
+package lib
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// diffContext lines of context around every hunk
+const diffContext int = 3
+
+type diffEdit struct {
+	Op   byte
+	Line string
+}
+
+// differ linear space implementation of Myers' O(ND) difference algorithm
+type differ struct {
+	a, b []string
+}
+
+// splitLines split text into lines, keeping the trailing newlines
+func splitLines(b []byte) []string {
+	if len(b) == 0 {
+		return []string{}
+	}
+	lines := strings.SplitAfter(string(b), "\n")
+	if len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// compare diff a[a0:a1] against b[b0:b1]
+func (d differ) compare(a0, a1, b0, b1 int) (edits []diffEdit) {
+	for a0 < a1 && b0 < b1 && d.a[a0] == d.b[b0] {
+		edits = append(edits, diffEdit{' ', d.a[a0]})
+		a0++
+		b0++
+	}
+
+	var suffix []diffEdit
+	for a0 < a1 && b0 < b1 && d.a[a1-1] == d.b[b1-1] {
+		suffix = append([]diffEdit{{' ', d.a[a1-1]}}, suffix...)
+		a1--
+		b1--
+	}
+
+	switch {
+	case a0 == a1:
+		for _, line := range d.b[b0:b1] {
+			edits = append(edits, diffEdit{'+', line})
+		}
+	case b0 == b1:
+		for _, line := range d.a[a0:a1] {
+			edits = append(edits, diffEdit{'-', line})
+		}
+	default:
+		x, y, u, v := d.middleSnake(a0, a1, b0, b1)
+		edits = append(edits, d.compare(a0, x, b0, y)...)
+		for _, line := range d.a[x:u] {
+			edits = append(edits, diffEdit{' ', line})
+		}
+		edits = append(edits, d.compare(u, a1, v, b1)...)
+	}
+
+	return append(edits, suffix...)
+}
+
+// middleSnake find the middle snake (x,y)-(u,v) of the shortest edit script of a[a0:a1] and b[b0:b1]
+func (d differ) middleSnake(a0, a1, b0, b1 int) (int, int, int, int) {
+	n, m := a1-a0, b1-b0
+	delta := n - m
+	odd := delta%2 != 0
+	max := (n + m + 1) / 2
+	off := max + 1
+	vf := make([]int, 2*off+1)
+	vb := make([]int, 2*off+1)
+
+	for step := 0; step <= max; step++ {
+		// forward search
+		for k := -step; k <= step; k += 2 {
+			var x int
+			if k == -step || (k != step && vf[off+k-1] < vf[off+k+1]) {
+				x = vf[off+k+1]
+			} else {
+				x = vf[off+k-1] + 1
+			}
+			y := x - k
+			sx, sy := x, y
+			for x < n && y < m && d.a[a0+x] == d.b[b0+y] {
+				x++
+				y++
+			}
+			vf[off+k] = x
+			if kr := delta - k; odd && kr >= -(step-1) && kr <= step-1 && x+vb[off+kr] >= n {
+				return a0 + sx, b0 + sy, a0 + x, b0 + y
+			}
+		}
+		// backward search on the reversed sequences
+		for k := -step; k <= step; k += 2 {
+			var x int
+			if k == -step || (k != step && vb[off+k-1] < vb[off+k+1]) {
+				x = vb[off+k+1]
+			} else {
+				x = vb[off+k-1] + 1
+			}
+			y := x - k
+			sx, sy := x, y
+			for x < n && y < m && d.a[a1-1-x] == d.b[b1-1-y] {
+				x++
+				y++
+			}
+			vb[off+k] = x
+			if kf := delta - k; !odd && kf >= -step && kf <= step && x+vf[off+kf] >= n {
+				return a1 - x, b1 - y, a1 - sx, b1 - sy
+			}
+		}
+	}
+
+	// unreachable, the snakes always meet within max steps
+	return a0, b0, a0, b0
+}
+
+// UnifiedDiff return the unified diff between the old and new content of path.
+// an empty old or new content is treated as a missing file.
+func UnifiedDiff(path string, old, new []byte) string {
+	if bytes.Equal(old, new) {
+		return ""
+	}
+
+	d := differ{splitLines(old), splitLines(new)}
+	edits := d.compare(0, len(d.a), 0, len(d.b))
+
+	from, to := path, path
+	if len(old) == 0 {
+		from = "/dev/null"
+	}
+	if len(new) == 0 {
+		to = "/dev/null"
+	}
+	str := fmt.Sprintf("--- %s\n+++ %s\n", from, to)
+
+	// positions of changed lines
+	var changes []int
+	for i, e := range edits {
+		if e.Op != ' ' {
+			changes = append(changes, i)
+		}
+	}
+
+	for i := 0; i < len(changes); {
+		start := changes[i] - diffContext
+		if start < 0 {
+			start = 0
+		}
+		end := changes[i]
+		for i < len(changes) && changes[i]-end <= 2*diffContext {
+			end = changes[i]
+			i++
+		}
+		end += diffContext + 1
+		if end > len(edits) {
+			end = len(edits)
+		}
+		str += genHunk(edits, start, end)
+	}
+
+	return str
+}
+
+// genHunk format edits[start:end] as a unified diff hunk
+func genHunk(edits []diffEdit, start, end int) string {
+	// line numbers of the hunk's first line in old and new files
+	oldLine, newLine := 1, 1
+	for _, e := range edits[:start] {
+		if e.Op != '+' {
+			oldLine++
+		}
+		if e.Op != '-' {
+			newLine++
+		}
+	}
+
+	var oldCount, newCount int
+	var body string
+	for _, e := range edits[start:end] {
+		if e.Op != '+' {
+			oldCount++
+		}
+		if e.Op != '-' {
+			newCount++
+		}
+		body += string(e.Op) + e.Line
+		if !strings.HasSuffix(e.Line, "\n") {
+			body += "\n\\ No newline at end of file\n"
+		}
+	}
+
+	// an empty range starts at the line before it
+	if oldCount == 0 {
+		oldLine--
+	}
+	if newCount == 0 {
+		newLine--
+	}
+
+	return fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", oldLine, oldCount, newLine, newCount) + body
+}
+
+// DiffInstalled write the unified diff between every file kept in w and the one installed on disk to out.
+// returns whether any difference was found.
+func DiffInstalled(w *MemoryWriter, out io.Writer) (bool, error) {
+	changed := false
+	for _, path := range w.Paths {
+		installed, err := ioutil.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return changed, err
+		}
+		diff := UnifiedDiff(path, installed, w.Files[path])
+		if len(diff) == 0 {
+			continue
+		}
+		changed = true
+		_, err = io.WriteString(out, diff)
+		if err != nil {
+			return changed, err
+		}
+	}
+	return changed, nil
+}
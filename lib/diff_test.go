@@ -0,0 +1,86 @@
+package lib
+
+import (
+	"strings"
+	"testing"
+)
+
+// lines join the letters of s as lines, like splitLines returns them
+func lines(s string) []string {
+	var l []string
+	for _, c := range s {
+		l = append(l, string(c)+"\n")
+	}
+	return l
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		// changes the number of removed plus added lines of the shortest edit script
+		changes int
+	}{
+		{"", "", 0},
+		{"abc", "abc", 0},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"abc", "abd", 2},
+		{"abcabba", "cbabac", 5},
+		{"abcdefg", "xbcdefy", 4},
+		{"aaaa", "aa", 2},
+		{"abab", "baba", 2},
+	}
+	for _, tt := range tests {
+		d := differ{lines(tt.a), lines(tt.b)}
+		edits := d.compare(0, len(d.a), 0, len(d.b))
+
+		var old, new string
+		changes := 0
+		for _, e := range edits {
+			if e.Op != '+' {
+				old += e.Line
+			}
+			if e.Op != '-' {
+				new += e.Line
+			}
+			if e.Op != ' ' {
+				changes++
+			}
+		}
+		if old != strings.Join(d.a, "") || new != strings.Join(d.b, "") {
+			t.Errorf("%q -> %q: edits do not rebuild both sides: %q %q", tt.a, tt.b, old, new)
+		}
+		if changes != tt.changes {
+			t.Errorf("%q -> %q: %d changed lines, want %d", tt.a, tt.b, changes, tt.changes)
+		}
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new string
+		want     string
+	}{
+		{"equal", "a\nb\n", "a\nb\n", ""},
+		{"new file", "", "a\nb\n", "--- /dev/null\n+++ f\n@@ -0,0 +1,2 @@\n+a\n+b\n"},
+		{"removed file", "a\n", "", "--- f\n+++ /dev/null\n@@ -1,1 +0,0 @@\n-a\n"},
+		{"separate hunks",
+			"a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nm\nn\n",
+			"a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nM\nn\n",
+			"--- f\n+++ f\n@@ -1,5 +1,5 @@\n a\n-b\n+B\n c\n d\n e\n@@ -10,5 +10,5 @@\n j\n k\n l\n-m\n+M\n n\n"},
+		{"merged hunks",
+			"a\nb\nc\nd\ne\nf\ng\nh\n",
+			"a\nX\nc\nd\ne\nf\nY\nh\n",
+			"--- f\n+++ f\n@@ -1,8 +1,8 @@\n a\n-b\n+X\n c\n d\n e\n f\n-g\n+Y\n h\n"},
+		{"no newline at end of file",
+			"a\nb",
+			"a\nb\n",
+			"--- f\n+++ f\n@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+b\n"},
+	}
+	for _, tt := range tests {
+		if got := UnifiedDiff("f", []byte(tt.old), []byte(tt.new)); got != tt.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", tt.name, got, tt.want)
+		}
+	}
+}
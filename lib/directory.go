@@ -68,9 +68,9 @@ func (f FontScale) Less(i, j int) bool {
 }
 
 // getX11FontDirs get all directories containing fonts except those in the blacklist
-func getX11FontDirs(cfg sysconfig.Config) map[string]struct{} {
+func getX11FontDirs(cfg sysconfig.Settings) map[string]struct{} {
 	blacklist := map[string]struct{}{"/usr/share/fonts": {}, "/usr/share/fonts/encodings": {}, "/usr/share/fonts/encodings/large": {}}
-	fontPaths := font.GetFontPaths()
+	fontPaths := font.GetFontPaths(root, false)
 	fontDirs := make(map[string]struct{})
 	for _, v := range fontPaths {
 		base := filepath.Dir(v)
@@ -83,12 +83,12 @@ func getX11FontDirs(cfg sysconfig.Config) map[string]struct{} {
 		fontDirs[base] = struct{}{}
 	}
 
-	// usually /usr/share/fonts/cyrillic is not acquired by fc-list
+	// usually /usr/share/fonts/cyrillic holds no font files of its own
 	if _, ok := fontDirs["/usr/share/fonts/cyrillic"]; !ok {
 		fontDirs["/usr/share/fonts/cyrillic"] = struct{}{}
 	}
 
-	Dbg(cfg.Int("VERBOSITY"), Debug, func() string {
+	Dbg(cfg.Verbosity, Debug, func() string {
 		str := "--- Font Directories\n"
 		for d := range fontDirs {
 			str += "\t" + d + "\n"
@@ -134,32 +134,32 @@ func createSymlink(d string) error {
 }
 
 // switchTTCap switch between Freetype style or X-TT style TTCap
-func switchTTCap(s string, cfg sysconfig.Config) string {
+func switchTTCap(s string, cfg sysconfig.Settings) string {
 	// http://x-tt.osdn.jp/xtt-1.3/INSTALL.eng.txt
 	freetypeRe := regexp.MustCompile(`:(\d):`)
 	xttRe := regexp.MustCompile(`:fn=(\d):`)
 	ttcapRe := regexp.MustCompile(`(?i)[[:alpha:]]+=`)
-	if cfg.Bool("GENERATE_TTCAP_ENTRIES") {
+	if cfg.GenerateTTCapEntries {
 		if freetypeRe.MatchString(s) {
 			m := freetypeRe.FindStringSubmatch(s)
-			Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("-ttcap option is set: convert face number to TTCap syntax: fn=%s\n", m[1]))
+			Dbg(cfg.Verbosity, Debug, fmt.Sprintf("-ttcap option is set: convert face number to TTCap syntax: fn=%s\n", m[1]))
 			s = strings.Replace(s, m[0], ":fn="+m[1]+":", 1)
 		}
 	} else {
 		if xttRe.MatchString(s) {
 			m := xttRe.FindStringSubmatch(s)
-			Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("-ttcap option is not set: convert face number to Freetype syntax: :%s:\n", m[1]))
+			Dbg(cfg.Verbosity, Debug, fmt.Sprintf("-ttcap option is not set: convert face number to Freetype syntax: :%s:\n", m[1]))
 			s = strings.Replace(s, m[0], ":"+m[1]+":", 1)
 		}
 		if ttcapRe.MatchString(s) {
 			// there's more than just a face number, better ignore it
-			Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("Unsupported entry: %s\n", s))
+			Dbg(cfg.Verbosity, Debug, fmt.Sprintf("Unsupported entry: %s\n", s))
 		}
 	}
 	return s
 }
 
-func generateObliqueFromItalic(fontScale *FontScale, cfg sysconfig.Config) {
+func generateObliqueFromItalic(fontScale *FontScale, cfg sysconfig.Settings) {
 	// generate an oblique entry if only italic is there and vice versa:
 	re := regexp.MustCompile(`(?i)(-[^-]+-[^-]+-[^-]+)(-[io]-)([^-]+-[^-]*-\d+-\d+-\d+-\d+-[pmc]-\d+-[^-]+-[^-]+)`)
 
@@ -174,19 +174,19 @@ func generateObliqueFromItalic(fontScale *FontScale, cfg sysconfig.Config) {
 			}
 			if _, ok := fontScale.Find(xlfd); !ok {
 				slice.Concat(fontScale, FontScaleEntry{f.Font, xlfd, f.Option})
-				Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("generated o/i: %s %s\n", f.Option+f.Font, xlfd))
+				Dbg(cfg.Verbosity, Debug, fmt.Sprintf("generated o/i: %s %s\n", f.Option+f.Font, xlfd))
 			}
 		}
 	}
 }
 
-func generateTTCap(fs *FontScale, cfg sysconfig.Config) {
+func generateTTCap(fs *FontScale, cfg sysconfig.Settings) {
 	// https://wiki.archlinux.org/index.php/X_Logical_Font_Description
-	if !cfg.Bool("GENERATE_TTCAP_ENTRIES") {
+	if !cfg.GenerateTTCapEntries {
 		return
 	}
 
-	Dbg(cfg.Int("VERBOSITY"), Debug, "generating TTCap options ...\n")
+	Dbg(cfg.Verbosity, Debug, "generating TTCap options ...\n")
 
 	re := regexp.MustCompile(`-medium-r`)
 	suffix := []string{".ttf", ".ttc", ".otf", ".otc", ".pfa", ".pfb"}
@@ -214,27 +214,27 @@ func generateTTCap(fs *FontScale, cfg sysconfig.Config) {
 
 			if _, ok := fs.Find(italic); ok {
 				slice.Concat(fs, FontScaleEntry{f.Font, italic, artificialItalic})
-				Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("generated TTCap entry: %s %s\n", artificialItalic+f.Font, italic))
+				Dbg(cfg.Verbosity, Debug, fmt.Sprintf("generated TTCap entry: %s %s\n", artificialItalic+f.Font, italic))
 			}
 
 			if _, ok := fs.Find(oblique); ok {
 				slice.Concat(fs, FontScaleEntry{f.Font, oblique, artificialItalic})
-				Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("generated TTCap entry: %s %s\n", artificialItalic+f.Font, oblique))
+				Dbg(cfg.Verbosity, Debug, fmt.Sprintf("generated TTCap entry: %s %s\n", artificialItalic+f.Font, oblique))
 			}
 
 			if _, ok := fs.Find(bold); ok {
 				slice.Concat(fs, FontScaleEntry{f.Font, bold, doubleStrike})
-				Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("generated TTCap entry: %s %s\n", doubleStrike+f.Font, bold))
+				Dbg(cfg.Verbosity, Debug, fmt.Sprintf("generated TTCap entry: %s %s\n", doubleStrike+f.Font, bold))
 			}
 
 			if _, ok := fs.Find(boldItalic); ok {
 				slice.Concat(fs, FontScaleEntry{f.Font, boldItalic, doubleStrike + artificialItalic})
-				Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("generated TTCap entry: %s %s\n", doubleStrike+artificialItalic+f.Font, boldItalic))
+				Dbg(cfg.Verbosity, Debug, fmt.Sprintf("generated TTCap entry: %s %s\n", doubleStrike+artificialItalic+f.Font, boldItalic))
 			}
 
 			if _, ok := fs.Find(boldOblique); ok {
 				slice.Concat(fs, FontScaleEntry{f.Font, boldOblique, doubleStrike + artificialItalic})
-				Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("generated TTCap entry: %s %s\n", doubleStrike+artificialItalic+f.Font, boldOblique))
+				Dbg(cfg.Verbosity, Debug, fmt.Sprintf("generated TTCap entry: %s %s\n", doubleStrike+artificialItalic+f.Font, boldOblique))
 			}
 		}
 	}
@@ -254,7 +254,7 @@ func generateTTCap(fs *FontScale, cfg sysconfig.Config) {
 
 		if strings.Contains(f.XLFD, "c-0-jisx0201.1976-0") {
 			slice.Replace(fs, f, FontScaleEntry{f.Font, f.XLFD, f.Option + "bw=0.5:"})
-			Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("added bw=0.5 option: %s %s\n", f.Option+"bw=0.5:"+f.Font, f.XLFD))
+			Dbg(cfg.Verbosity, Debug, fmt.Sprintf("added bw=0.5 option: %s %s\n", f.Option+"bw=0.5:"+f.Font, f.XLFD))
 		}
 	}
 }
@@ -271,7 +271,7 @@ func decodeXLFD(s string) (string, string, string, error) {
 }
 
 // fixHomeMadeFontScales fix homemade font scale entries in d/font.scale.*
-func fixHomeMadeFontScales(d string, fontScale string, cfg sysconfig.Config, fontScales *FontScale) (map[string]bool, error) {
+func fixHomeMadeFontScales(d string, fontScale string, cfg sysconfig.Settings, fontScales *FontScale) (map[string]bool, error) {
 	blacklist := make(map[string]bool)
 
 	data, err := os.Open(fontScale)
@@ -280,7 +280,7 @@ func fixHomeMadeFontScales(d string, fontScale string, cfg sysconfig.Config, fon
 	}
 	defer data.Close()
 
-	Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("reading %s ...\n", filepath.Join(d, fontScale)))
+	Dbg(cfg.Verbosity, Debug, fmt.Sprintf("reading %s ...\n", filepath.Join(d, fontScale)))
 
 	scanner := bufio.NewScanner(data)
 	scanner.Split(bufio.ScanLines)
@@ -292,7 +292,7 @@ func fixHomeMadeFontScales(d string, fontScale string, cfg sysconfig.Config, fon
 			continue
 		}
 
-		Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("handmade entry found: options=%s font=%s xlfd=%s\n", ttOptions, familyName, xlfd))
+		Dbg(cfg.Verbosity, Debug, fmt.Sprintf("handmade entry found: options=%s font=%s xlfd=%s\n", ttOptions, familyName, xlfd))
 
 		switchTTCap(ttOptions, cfg)
 
@@ -304,12 +304,12 @@ func fixHomeMadeFontScales(d string, fontScale string, cfg sysconfig.Config, fon
 
 			For other entries, we check whether the file exists. */
 			if _, err := os.Stat(filepath.Join(d, familyName)); os.IsNotExist(err) {
-				Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("file %s doesn't exist, discard enntry %s\n", filepath.Join(d, familyName), line))
+				Dbg(cfg.Verbosity, Debug, fmt.Sprintf("file %s doesn't exist, discard enntry %s\n", filepath.Join(d, familyName), line))
 				continue
 			}
 		}
 
-		Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("adding handmade entry %s\n", line))
+		Dbg(cfg.Verbosity, Debug, fmt.Sprintf("adding handmade entry %s\n", line))
 		slice.Concat(fontScales, FontScaleEntry{familyName, xlfd, ttOptions})
 		/* This font has "handmade" fonts.scale entries.
 		Add it to the blacklist to discard any entries for this font
@@ -321,7 +321,7 @@ func fixHomeMadeFontScales(d string, fontScale string, cfg sysconfig.Config, fon
 }
 
 // fixSystemFontScale fix font scale entries in d/fonts.scale file
-func fixSystemFontScale(d string, cfg sysconfig.Config, fontScales *FontScale, blacklist map[string]bool) error {
+func fixSystemFontScale(d string, cfg sysconfig.Settings, fontScales *FontScale, blacklist map[string]bool) error {
 	systemFileScale := filepath.Join(d, "fonts.scale")
 
 	data, err := os.Open(systemFileScale)
@@ -330,7 +330,7 @@ func fixSystemFontScale(d string, cfg sysconfig.Config, fontScales *FontScale, b
 	}
 	defer data.Close()
 
-	Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("reading %s ...\n", systemFileScale))
+	Dbg(cfg.Verbosity, Debug, fmt.Sprintf("reading %s ...\n", systemFileScale))
 
 	scanner := bufio.NewScanner(data)
 	scanner.Split(bufio.ScanLines)
@@ -342,7 +342,7 @@ func fixSystemFontScale(d string, cfg sysconfig.Config, fontScales *FontScale, b
 			continue
 		}
 
-		Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("mkfontscale entry found: options=%s font=%s xlfd=%s\n", ttOptions, familyName, xlfd))
+		Dbg(cfg.Verbosity, Debug, fmt.Sprintf("mkfontscale entry found: options=%s font=%s xlfd=%s\n", ttOptions, familyName, xlfd))
 
 		/* mkfontscale apparently doesn't yet generate the special options for
 		the freetype module to use different face numbers in .ttc files.
@@ -350,7 +350,7 @@ func fixSystemFontScale(d string, cfg sysconfig.Config, fontScales *FontScale, b
 		switchTTCap(ttOptions, cfg)
 
 		if blacklist[familyName] {
-			Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("%s is blacklisted, ignored.\n", filepath.Join(d, familyName)))
+			Dbg(cfg.Verbosity, Debug, fmt.Sprintf("%s is blacklisted, ignored.\n", filepath.Join(d, familyName)))
 			continue
 		}
 		slice.Concat(fontScales, FontScaleEntry{familyName, xlfd, ttOptions})
@@ -388,8 +388,8 @@ func writeSystemFontScale(dst string, fs FontScale, verbosity int) error {
 	return nil
 }
 
-func fixFontScales(dr string, cfg sysconfig.Config) error {
-	Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("------\nfix fonts.scale in %s\n", dr))
+func fixFontScales(dr string, cfg sysconfig.Settings) error {
+	Dbg(cfg.Verbosity, Debug, fmt.Sprintf("------\nfix fonts.scale in %s\n", dr))
 
 	var fs FontScale
 	blacklist := make(map[string]bool)
@@ -403,7 +403,7 @@ func fixFontScales(dr string, cfg sysconfig.Config) error {
 	for _, f := range handmades {
 		suffix := []string{".swp", ".bak", ".sav", ".save", ".rpmsave", ".rpmorig", ".rpmnew"}
 		if ok, _, _ := stringutils.Contains(f, suffix...); ok {
-			Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("%s is considered a backup file, ignored.\n", f))
+			Dbg(cfg.Verbosity, Debug, fmt.Sprintf("%s is considered a backup file, ignored.\n", f))
 			continue
 		}
 
@@ -422,7 +422,7 @@ func fixFontScales(dr string, cfg sysconfig.Config) error {
 	generateObliqueFromItalic(&fs, cfg)
 	generateTTCap(&fs, cfg)
 
-	err = writeSystemFontScale(filepath.Join(dr, "fonts.scale"), fs, cfg.Int("VERBOSITY"))
+	err = writeSystemFontScale(filepath.Join(dr, "fonts.scale"), fs, cfg.Verbosity)
 	if err != nil {
 		return err
 	}
@@ -490,24 +490,24 @@ func applyTimestamp(timestamp, dst, fontScale, fontDir string) {
 }
 
 // makeFontScaleAndDir: make fonts.scale and fonts.dir in the provided directory.
-func makeFontScaleAndFontDir(d string, cfg sysconfig.Config, force bool) error {
+func makeFontScaleAndFontDir(d string, cfg sysconfig.Settings, force bool) error {
 	timestamp := filepath.Join(d, "/.fonts-config-timestamp")
 	fs := filepath.Join(d, "/fonts.scale")
 	fd := filepath.Join(d, "/fonts.dir")
 
-	if force || chkScaleAndDirUpdate(d, timestamp, fs, fd, cfg.Int("VERBOSITY")) {
+	if force || chkScaleAndDirUpdate(d, timestamp, fs, fd, cfg.Verbosity) {
 
-		Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("%s: creating fonts.{scale,dir}\n", d))
+		Dbg(cfg.Verbosity, Debug, fmt.Sprintf("%s: creating fonts.{scale,dir}\n", d))
 
 		cleanFontScaleAndFontDir(fs, fd)
 		createSymlink(d)
 
 		if _, err := os.Stat("/usr/bin/mkfontscale"); !os.IsNotExist(err) {
 			cmd, _ := exec.Command("/usr/bin/mkfontscale", d).Output()
-			Dbg(cfg.Int("VERBOSITY"), Debug, string(cmd)+"\n")
+			Dbg(cfg.Verbosity, Debug, string(cmd)+"\n")
 		}
 
-		touchFontScale(fs, cfg.Int("VERBOSITY"))
+		touchFontScale(fs, cfg.Verbosity)
 
 		err := fixFontScales(d, cfg)
 		if err != nil {
@@ -516,7 +516,7 @@ func makeFontScaleAndFontDir(d string, cfg sysconfig.Config, force bool) error {
 
 		if _, err := os.Stat("/usr/bin/mkfontdir"); !os.IsNotExist(err) {
 			flags := make([]string, 5)
-			for _, v := range []string{"/usr/share/fonts/encodings", "/usr/share/fonts/encodings/large"} {
+			for _, v := range []string{RootPath("/usr/share/fonts/encodings"), RootPath("/usr/share/fonts/encodings/large")} {
 				if _, err := os.Stat(v); !os.IsNotExist(err) {
 					flags = append(flags, "-e")
 					flags = append(flags, v)
@@ -524,10 +524,10 @@ func makeFontScaleAndFontDir(d string, cfg sysconfig.Config, force bool) error {
 			}
 			flags = append(flags, d)
 			cmd, _ := exec.Command("/usr/bin/mkfontdir", flags...).Output()
-			Dbg(cfg.Int("VERBOSITY"), Debug, string(cmd)+"\n")
+			Dbg(cfg.Verbosity, Debug, string(cmd)+"\n")
 		}
 
-		tryAgain := createOrCopyFontDirFile(fd, fs, cfg.Int("VERBOSITY"))
+		tryAgain := createOrCopyFontDirFile(fd, fs, cfg.Verbosity)
 
 		// Directory done. Now update time stamps:
 		if tryAgain {
@@ -550,9 +550,9 @@ func makeFontScaleAndFontDir(d string, cfg sysconfig.Config, force bool) error {
 }
 
 // MkFontScaleDir make fonts.scale and fonts.dir in font directories based on our fonts-config options
-func MkFontScaleAndFontDir(c sysconfig.Config, force bool) error {
+func MkFontScaleAndFontDir(c sysconfig.Settings, force bool) error {
 	for d := range getX11FontDirs(c) {
-		err := makeFontScaleAndFontDir(d, c, force)
+		err := makeFontScaleAndFontDir(RootPath(d), c, force)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,474 @@
+package lib
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	ft "github.com/marguerite/fonts-config-ng/font"
+	"github.com/marguerite/fonts-config-ng/fontconfig"
+)
+
+// ExplainStep a rule changing the family list of the pattern
+type ExplainStep struct {
+	File   string
+	Line   int
+	Origin string
+	// Rule "match" or "alias"
+	Rule    string
+	Mode    string
+	Binding string
+	Added   []string
+	Removed []string
+}
+
+func (s ExplainStep) String() string {
+	str := fmt.Sprintf("%s:%d: [%s] %s %s", s.File, s.Line, s.Origin, s.Rule, s.Mode)
+	if len(s.Added) > 0 {
+		str += fmt.Sprintf(" %q", s.Added)
+	}
+	if len(s.Removed) > 0 {
+		str += fmt.Sprintf(" removing %q", s.Removed)
+	}
+	if len(s.Binding) > 0 {
+		str += " (" + s.Binding + ")"
+	}
+	return str
+}
+
+// ExplainCandidate a family of the final family list, the rule adding it and the installed fonts it matches
+type ExplainCandidate struct {
+	Family  string
+	Binding string
+	File    string
+	Line    int
+	Fonts   ft.Collection
+}
+
+// Explanation how fontconfig resolves a family request
+type Explanation struct {
+	Family     string
+	Lang       string
+	UserMode   bool
+	Steps      []ExplainStep
+	Candidates []ExplainCandidate
+	// Resolved the font the request most likely ends up with, nil if no candidate is installed
+	Resolved *ft.Font
+	// Notes the files skipped as they could not be parsed, fontconfig skips them too
+	Notes []string
+}
+
+// explainer the state of the family substitution
+type explainer struct {
+	userMode   bool
+	lang       string
+	candidates []ExplainCandidate
+	// properties the other elements of the pattern set by the rules, eg. search_metric_aliases
+	properties map[string][]string
+	steps      []ExplainStep
+	visited    map[string]bool
+	notes      []string
+}
+
+// defaultLang the language fontconfig adds to a pattern without one, taken from the locale
+func defaultLang() string {
+	for _, env := range []string{"FC_LANG", "LC_ALL", "LC_CTYPE", "LANG"} {
+		v := os.Getenv(env)
+		if len(v) == 0 {
+			continue
+		}
+		v = strings.SplitN(strings.SplitN(v, ".", 2)[0], "@", 2)[0]
+		if v == "C" || v == "POSIX" {
+			return "en"
+		}
+		return strings.ToLower(strings.Replace(v, "_", "-", -1))
+	}
+	return "en"
+}
+
+// exprStrings the constant values of exprs as strings, expressions needing evaluation are skipped
+func exprStrings(exprs []fontconfig.Expr) []string {
+	var values []string
+	for _, e := range exprs {
+		switch v := e.(type) {
+		case fontconfig.String:
+			values = append(values, string(v))
+		case fontconfig.Const:
+			values = append(values, string(v))
+		case fontconfig.Bool:
+			values = append(values, strconv.FormatBool(bool(v)))
+		case fontconfig.Int:
+			values = append(values, strconv.Itoa(int(v)))
+		case fontconfig.Double:
+			values = append(values, strconv.FormatFloat(float64(v), 'g', -1, 64))
+		}
+	}
+	return values
+}
+
+// compare apply the compare operator of a test to a value of the pattern
+func compare(op, value, test string, equal func(string, string) bool) bool {
+	switch op {
+	case "", "eq":
+		return equal(value, test)
+	case "not_eq":
+		return !equal(value, test)
+	case "contains":
+		return strings.Contains(strings.ToLower(value), strings.ToLower(test))
+	case "not_contains":
+		return !strings.Contains(strings.ToLower(value), strings.ToLower(test))
+	}
+	return false
+}
+
+// test evaluate t against the pattern, returns whether it matches and the position of the matching family, or -1.
+// like in fontconfig, a test on an element missing from the pattern only matches with qual="all".
+func (e *explainer) test(t fontconfig.Test) (bool, int) {
+	values := exprStrings(t.Values)
+
+	var pattern []string
+	equal := strings.EqualFold
+	switch t.Name {
+	case "family":
+		for _, c := range e.candidates {
+			pattern = append(pattern, c.Family)
+		}
+		equal = ft.FamilyEqual
+	case "lang":
+		pattern = []string{e.lang}
+		equal = ft.LangMatches
+	default:
+		pattern = e.properties[t.Name]
+	}
+	if len(pattern) == 0 {
+		return t.Qual == "all", -1
+	}
+
+	matches := func(i int) bool {
+		for _, v := range values {
+			if compare(t.Compare, pattern[i], v, equal) {
+				return true
+			}
+		}
+		return false
+	}
+	pos := func(i int) int {
+		if t.Name == "family" {
+			return i
+		}
+		return -1
+	}
+
+	switch t.Qual {
+	case "all":
+		for i := range pattern {
+			if !matches(i) {
+				return false, -1
+			}
+		}
+		return true, pos(0)
+	case "first":
+		if matches(0) {
+			return true, pos(0)
+		}
+		return false, -1
+	}
+	start := 0
+	if t.Qual == "not_first" {
+		start = 1
+	}
+	for i := start; i < len(pattern); i++ {
+		if matches(i) {
+			return true, pos(i)
+		}
+	}
+	return false, -1
+}
+
+// edit apply a family edit of mode at the matched position pos, or -1, and record it as step
+func (e *explainer) edit(step ExplainStep, values []string, pos int) {
+	binding := step.Binding
+	if binding == "same" && pos >= 0 {
+		binding = e.candidates[pos].Binding
+	}
+	if binding == "" || binding == "same" {
+		binding = "weak"
+	}
+
+	var added []ExplainCandidate
+	for _, v := range values {
+		added = append(added, ExplainCandidate{Family: v, Binding: binding, File: step.File, Line: step.Line})
+	}
+
+	insert := func(at int) {
+		c := append([]ExplainCandidate{}, e.candidates[:at]...)
+		c = append(c, added...)
+		e.candidates = append(c, e.candidates[at:]...)
+	}
+	remove := func(from, to int) {
+		for _, c := range e.candidates[from:to] {
+			step.Removed = append(step.Removed, c.Family)
+		}
+		e.candidates = append(e.candidates[:from], e.candidates[to:]...)
+	}
+
+	switch step.Mode {
+	case "", "assign":
+		if pos >= 0 {
+			remove(pos, pos+1)
+			insert(pos)
+		} else {
+			remove(0, len(e.candidates))
+			insert(0)
+		}
+	case "assign_replace":
+		remove(0, len(e.candidates))
+		insert(0)
+	case "prepend":
+		if pos < 0 {
+			pos = 0
+		}
+		insert(pos)
+	case "append":
+		if pos < 0 {
+			pos = len(e.candidates) - 1
+		}
+		insert(pos + 1)
+	case "prepend_first":
+		insert(0)
+	case "append_last":
+		insert(len(e.candidates))
+	case "delete":
+		if pos >= 0 {
+			remove(pos, pos+1)
+		} else {
+			remove(0, len(e.candidates))
+		}
+		added = nil
+	case "delete_all":
+		remove(0, len(e.candidates))
+		added = nil
+	default:
+		return
+	}
+
+	if len(added) > 0 {
+		step.Added = values
+	}
+	e.steps = append(e.steps, step)
+}
+
+// tests evaluate every test, returns whether all match and the position of the matching family
+func (e *explainer) tests(tests []fontconfig.Test) (bool, int) {
+	pos := -1
+	for _, t := range tests {
+		ok, p := e.test(t)
+		if !ok {
+			return false, -1
+		}
+		if p >= 0 && pos < 0 {
+			pos = p
+		}
+	}
+	return true, pos
+}
+
+// process apply the family rules of the configuration file to the pattern, a file that can not be
+// parsed is noted and skipped
+func (e *explainer) process(file string) {
+	if e.visited[file] {
+		return
+	}
+	e.visited[file] = true
+
+	doc, err := fontconfig.ParseFile(file)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			e.notes = append(e.notes, err.Error())
+		}
+		return
+	}
+	origin := getConfOrigin(file, doc).String()
+
+	for _, n := range doc.Nodes {
+		switch v := n.(type) {
+		case fontconfig.Include:
+			for _, f := range fontconfig.ResolveInclude(v, file, root, e.userMode) {
+				e.process(f)
+			}
+		case fontconfig.Match:
+			// only the request pattern matters, how fonts are rendered doesn't
+			if v.Target != "" && v.Target != "pattern" {
+				continue
+			}
+			ok, pos := e.tests(v.Tests)
+			if !ok {
+				continue
+			}
+			for _, ed := range v.Edits {
+				switch ed.Name {
+				case "family":
+					e.edit(ExplainStep{File: file, Line: ed.Line, Origin: origin, Rule: "match", Mode: ed.Mode,
+						Binding: ed.Binding}, exprStrings(ed.Values), pos)
+				case "lang":
+				default:
+					// good enough to evaluate later tests on the element
+					if values := exprStrings(ed.Values); len(values) > 0 {
+						e.properties[ed.Name] = values
+					}
+				}
+			}
+		case fontconfig.Alias:
+			if ok, _ := e.tests(v.Tests); !ok {
+				continue
+			}
+			for _, family := range v.Family {
+				ok, pos := e.test(fontconfig.Test{Name: "family", Values: []fontconfig.Expr{fontconfig.String(family)}})
+				if !ok {
+					continue
+				}
+				step := ExplainStep{File: file, Line: v.Line, Origin: origin, Rule: "alias", Binding: v.Binding}
+				// prefer goes before the family, accept after it, default at the end
+				if v.Accept != nil && len(v.Accept.Families) > 0 {
+					step.Mode = "append"
+					e.edit(step, v.Accept.Families, pos)
+				}
+				if v.Prefer != nil && len(v.Prefer.Families) > 0 {
+					step.Mode = "prepend"
+					e.edit(step, v.Prefer.Families, pos)
+				}
+				if v.Default != nil && len(v.Default.Families) > 0 {
+					step.Mode = "append_last"
+					e.edit(step, v.Default.Families, pos)
+				}
+			}
+		}
+	}
+}
+
+// Explain follow the family substitution rules of the installed configuration for a request of family
+// in lang, the language of the locale if empty, and find the installed fonts of c satisfying them
+func Explain(c ft.Collection, family, lang string, userMode bool) (Explanation, error) {
+	if len(lang) == 0 {
+		lang = defaultLang()
+	}
+	e := explainer{userMode: userMode, lang: lang, properties: make(map[string][]string), visited: make(map[string]bool),
+		candidates: []ExplainCandidate{{Family: family, Binding: "strong"}}}
+
+	files, _ := filepath.Glob(filepath.Join(RootPath("/etc/fonts/conf.d"), "*.conf"))
+	sort.Strings(files)
+	for _, f := range files {
+		e.process(f)
+	}
+
+	exp := Explanation{Family: family, Lang: lang, UserMode: userMode, Steps: e.steps, Notes: e.notes}
+	for _, cand := range e.candidates {
+		if exp.has(cand.Family) {
+			continue
+		}
+		cand.Fonts = c.ByFamily(cand.Family)
+		exp.Candidates = append(exp.Candidates, cand)
+	}
+	exp.Resolved = resolve(exp.Candidates, lang)
+	return exp, nil
+}
+
+// has whether family is a candidate already
+func (exp Explanation) has(family string) bool {
+	for _, cand := range exp.Candidates {
+		if ft.FamilyEqual(cand.Family, family) {
+			return true
+		}
+	}
+	return false
+}
+
+// regular the font of fonts closest to the regular, upright style a request defaults to
+func regular(fonts ft.Collection) *ft.Font {
+	best := 0
+	distance := func(f ft.Font) int {
+		d := f.Weight - 80
+		if d < 0 {
+			d = -d
+		}
+		return d + f.Slant
+	}
+	for i := range fonts {
+		if distance(fonts[i]) < distance(fonts[best]) {
+			best = i
+		}
+	}
+	return &fonts[best]
+}
+
+// resolve pick the font fontconfig most likely chooses: strongly bound families beat the language,
+// the language beats weakly bound families
+func resolve(candidates []ExplainCandidate, lang string) *ft.Font {
+	for _, cand := range candidates {
+		if cand.Binding == "strong" && len(cand.Fonts) > 0 {
+			return regular(cand.Fonts)
+		}
+	}
+	for _, cand := range candidates {
+		if fonts := cand.Fonts.ByLang(lang); len(fonts) > 0 {
+			return regular(fonts)
+		}
+	}
+	for _, cand := range candidates {
+		if len(cand.Fonts) > 0 {
+			return regular(cand.Fonts)
+		}
+	}
+	return nil
+}
+
+// Format print the explanation to out
+func (exp Explanation) Format(out io.Writer) {
+	mode := "system"
+	if exp.UserMode {
+		mode = "user"
+	}
+	fmt.Fprintf(out, "family %q, lang %q, %s configuration\n\n", exp.Family, exp.Lang, mode)
+
+	if len(exp.Notes) > 0 {
+		fmt.Fprintln(out, "Skipped, as fontconfig does:")
+		for _, n := range exp.Notes {
+			fmt.Fprintln(out, "  "+n)
+		}
+		fmt.Fprintln(out)
+	}
+
+	fmt.Fprintln(out, "Rules, in the order fontconfig applies them:")
+	if len(exp.Steps) == 0 {
+		fmt.Fprintln(out, "  none")
+	}
+	for _, s := range exp.Steps {
+		fmt.Fprintln(out, "  "+s.String())
+	}
+
+	fmt.Fprintln(out, "\nCandidates:")
+	for i, cand := range exp.Candidates {
+		origin := "requested"
+		if len(cand.File) > 0 {
+			origin = fmt.Sprintf("%s:%d", cand.File, cand.Line)
+		}
+		fmt.Fprintf(out, "  %2d. %s (%s) from %s\n", i+1, cand.Family, cand.Binding, origin)
+		for _, f := range cand.Fonts {
+			support := ""
+			if f.HasLang(exp.Lang) {
+				support = ", supports " + exp.Lang
+			}
+			fmt.Fprintf(out, "        %s:%d%s\n", f.File, f.Index, support)
+		}
+	}
+
+	if exp.Resolved == nil {
+		fmt.Fprintln(out, "\nResolved: no candidate is installed, fontconfig falls back to the best font for the language")
+		return
+	}
+	fmt.Fprintf(out, "\nResolved: %s (%s)\n", exp.Resolved.Name[0], exp.Resolved.File)
+}
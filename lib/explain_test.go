@@ -0,0 +1,151 @@
+package lib
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	ft "github.com/marguerite/fonts-config-ng/font"
+)
+
+// explainRoot family substitution rules for Arial, a language specific one and a user one
+var explainRoot = map[string]string{
+	"etc/fonts/conf.d/30-metric-aliases.conf": `<fontconfig>
+	<alias binding="same"><family>Arial</family><accept><family>Liberation Sans</family></accept></alias>
+</fontconfig>`,
+	"etc/fonts/conf.d/45-generic.conf": `<fontconfig>
+	<alias><family>Liberation Sans</family><default><family>sans-serif</family></default></alias>
+</fontconfig>`,
+	"etc/fonts/conf.d/50-user.conf": `<fontconfig>
+	<include ignore_missing="yes" prefix="xdg">fontconfig/fonts.conf</include>
+</fontconfig>`,
+	"etc/fonts/conf.d/60-lang.conf": `<fontconfig>
+	<match target="pattern">
+		<test name="lang"><string>ja</string></test>
+		<test qual="any" name="family"><string>sans-serif</string></test>
+		<edit name="family" mode="prepend"><string>Noto Sans CJK JP</string></edit>
+	</match>
+</fontconfig>`,
+	"etc/fonts/conf.d/65-prefer.conf": `<fontconfig>
+	<alias><family>sans-serif</family><prefer><family>DejaVu Sans</family><family>dejavusans</family></prefer></alias>
+</fontconfig>`,
+	"etc/fonts/conf.d/70-font.conf": `<fontconfig>
+	<match target="font"><edit name="family" mode="assign"><string>Ignored</string></edit></match>
+</fontconfig>`,
+	"home/user/.config/fontconfig/fonts.conf": `<fontconfig>
+	<match>
+		<test name="family"><string>liberationsans</string></test>
+		<edit name="family" mode="assign" binding="strong"><string>Mine</string></edit>
+	</match>
+</fontconfig>`,
+}
+
+func TestExplain(t *testing.T) {
+	setTestRoot(t, explainRoot)
+
+	liberation := ft.Font{File: "/usr/share/fonts/LiberationSans.ttf", Name: []string{"Liberation Sans"}, Weight: 80, Lang: []string{"en"}}
+	dejavu := ft.Collection{
+		{File: "/usr/share/fonts/DejaVuSans-Bold.ttf", Name: []string{"DejaVu Sans"}, Weight: 200, Lang: []string{"en", "de"}},
+		{File: "/usr/share/fonts/DejaVuSans.ttf", Name: []string{"DejaVu Sans"}, Weight: 80, Lang: []string{"en", "de"}},
+	}
+	noto := ft.Font{File: "/usr/share/fonts/NotoSansCJK.ttc", Name: []string{"Noto Sans CJK JP"}, Weight: 80, Lang: []string{"ja", "en"}}
+	all := append(ft.Collection{liberation, noto}, dejavu...)
+
+	tests := []struct {
+		name       string
+		fonts      ft.Collection
+		lang       string
+		userMode   bool
+		candidates []string
+		bindings   []string
+		steps      int
+		resolved   string
+	}{
+		// the strongly bound metric alias wins over the language
+		{"metric alias", all, "ja-JP", false,
+			[]string{"Arial", "Liberation Sans", "Noto Sans CJK JP", "DejaVu Sans", "sans-serif"},
+			[]string{"strong", "strong", "weak", "weak", "weak"}, 4, liberation.File},
+		// the language beats weakly bound families
+		{"language", ft.Collection{noto, dejavu[0], dejavu[1]}, "ja_JP", false,
+			[]string{"Arial", "Liberation Sans", "Noto Sans CJK JP", "DejaVu Sans", "sans-serif"},
+			[]string{"strong", "strong", "weak", "weak", "weak"}, 4, noto.File},
+		// the regular style of the first family
+		{"other language", ft.Collection{noto, dejavu[0], dejavu[1]}, "de", false,
+			[]string{"Arial", "Liberation Sans", "DejaVu Sans", "sans-serif"},
+			[]string{"strong", "strong", "weak", "weak"}, 3, dejavu[1].File},
+		{"nothing installed", nil, "en", false,
+			[]string{"Arial", "Liberation Sans", "DejaVu Sans", "sans-serif"},
+			[]string{"strong", "strong", "weak", "weak"}, 3, ""},
+		// the user configuration replaces Liberation Sans, none of the strongly bound families is installed
+		{"user", all, "en", true,
+			[]string{"Arial", "Mine", "DejaVu Sans", "sans-serif"},
+			[]string{"strong", "strong", "weak", "weak"}, 4, dejavu[1].File},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exp, err := Explain(tt.fonts, "Arial", tt.lang, tt.userMode)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var families, bindings []string
+			for _, c := range exp.Candidates {
+				families = append(families, c.Family)
+				bindings = append(bindings, c.Binding)
+			}
+			if !reflect.DeepEqual(families, tt.candidates) || !reflect.DeepEqual(bindings, tt.bindings) {
+				t.Errorf("candidates %q %q, want %q %q", families, bindings, tt.candidates, tt.bindings)
+			}
+			if len(exp.Steps) != tt.steps {
+				t.Errorf("steps:\n%v\nwant %d", exp.Steps, tt.steps)
+			}
+			resolved := ""
+			if exp.Resolved != nil {
+				resolved = exp.Resolved.File
+			}
+			if resolved != tt.resolved {
+				t.Errorf("resolved %q, want %q", resolved, tt.resolved)
+			}
+		})
+	}
+}
+
+func TestExplainUserStep(t *testing.T) {
+	setTestRoot(t, explainRoot)
+	exp, err := Explain(nil, "Arial", "en", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exp.Steps) < 3 {
+		t.Fatalf("steps %v", exp.Steps)
+	}
+	want := ExplainStep{File: RootPath("/home/user/.config/fontconfig/fonts.conf"), Line: 4, Origin: exp.Steps[2].Origin,
+		Rule: "match", Mode: "assign", Binding: "strong", Added: []string{"Mine"}, Removed: []string{"Liberation Sans"}}
+	if !reflect.DeepEqual(exp.Steps[2], want) {
+		t.Errorf("steps %v, want %v third", exp.Steps, want)
+	}
+}
+
+func TestExplainSkipsBrokenFile(t *testing.T) {
+	files := map[string]string{"etc/fonts/conf.d/40-broken.conf": "<fontconfig><alias>"}
+	for path, content := range explainRoot {
+		files[path] = content
+	}
+	setTestRoot(t, files)
+
+	exp, err := Explain(nil, "Arial", "en", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exp.Notes) != 1 || !strings.Contains(exp.Notes[0], "40-broken.conf") {
+		t.Errorf("notes %q, want the broken file", exp.Notes)
+	}
+	// the rules after the broken file still apply
+	if len(exp.Steps) != 3 {
+		t.Errorf("steps %v", exp.Steps)
+	}
+	var out strings.Builder
+	exp.Format(&out)
+	if !strings.Contains(out.String(), "40-broken.conf") {
+		t.Errorf("the broken file is not reported:\n%s", out.String())
+	}
+}
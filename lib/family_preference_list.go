@@ -2,11 +2,11 @@ package lib
 
 import (
 	"fmt"
-	"log"
 	"regexp"
 	"sort"
 	"strings"
 
+	"github.com/marguerite/fonts-config-ng/fontconfig"
 	"github.com/marguerite/fonts-config-ng/sysconfig"
 )
 
@@ -48,89 +48,77 @@ func NewOrderedList(list ...string) (ord OrderedList) {
 	return ord
 }
 
+// fixFamilyName remove comma and the rest of the family string #bsc998300
 func fixFamilyName(name string) string {
-	// remove comma and the rest of the family string #bsc998300
 	re := regexp.MustCompile(`^(.*?),.*$`)
 	if re.MatchString(name) {
 		name = re.FindStringSubmatch(name)[1]
 	}
-	name = strings.Replace(name, "&", "&amp;", -1)
 	return name
 }
 
-func buildFPL(genericName, preferredFamiliesInString string, userMode bool, cfg sysconfig.Config) string {
+func buildFPL(genericName, preferredFamiliesInString string, userMode bool, cfg sysconfig.Settings) []fontconfig.Node {
 	families := strings.Split(preferredFamiliesInString, ":")
 	genericName = fixFamilyName(genericName)
-	fpl := ""
 
 	if len(families) < 2 {
-		return ""
+		return nil
 	}
 
-	Dbg(cfg.Int("VERBOSITY"), Debug, func(force bool) string {
+	Dbg(cfg.Verbosity, Debug, func(force bool) string {
 		if force {
 			return fmt.Sprintf("Strongly preferred %s families: ", genericName)
 		}
 		return fmt.Sprintf("Preferred %s families: ", genericName)
-	}, cfg.Bool("FORCE_FAMILY_PREFERENCE_LISTS"))
+	}, cfg.ForceFamilyPreferenceLists)
 
-	if cfg.Bool("FORCE_FAMILY_PREFERENCE_LISTS") {
-		fpl += "\t<match>\n" +
-			"\t\t<test name=\"family\"><string>$family</string></test>\n" +
-			"\t\t<edit name=\"family\" mode=\"prepend_first\" binding=\"strong\">\n"
-
-		for _, font := range families {
-			font = fixFamilyName(font)
-			fpl += "\t\t\t<string>" + font + "</string>\n"
-			Dbg(cfg.Int("VERBOSITY"), Debug, "["+font+"]\n")
-		}
+	for i, font := range families {
+		families[i] = fixFamilyName(font)
+		Dbg(cfg.Verbosity, Debug, "["+families[i]+"]\n")
+	}
 
-		fpl += "\t\t</edit>\n\t</match>\n"
-	} else {
-		fpl += "\t<alias>\n"
-		if !userMode {
-			fpl += "\t\t<test name=\"user_preference_list\"><bool>false</bool></test>\n"
-		}
-		fpl += "\t\t<family>" + genericName + "</family>\n\t\t<prefer>\n"
+	if cfg.ForceFamilyPreferenceLists {
+		return []fontconfig.Node{fontconfig.Match{
+			Tests: []fontconfig.Test{familyTest(genericName, "")},
+			Edits: []fontconfig.Edit{familyEdit("prepend_first", "strong", families...)},
+		}}
+	}
 
-		for _, font := range families {
-			font = fixFamilyName(font)
-			fpl += "\t\t\t<family>" + font + "</family>\n"
-			Dbg(cfg.Int("VERBOSITY"), Debug, "["+font+"]\n")
-		}
-		fpl += "\t\t</prefer>\n\t</alias>\n"
+	alias := fontconfig.Alias{Family: []string{genericName}, Prefer: fontconfig.NewFamilyList(families...)}
+	if !userMode {
+		alias.Tests = []fontconfig.Test{{Name: "user_preference_list", Values: []fontconfig.Expr{fontconfig.Bool(false)}}}
 	}
+	return []fontconfig.Node{alias}
+}
 
-	return fpl
+// userPreferenceList mark pattern whether a user preference list is in use
+func userPreferenceList(b bool) fontconfig.Match {
+	return fontconfig.Match{
+		Target: "pattern",
+		Edits:  []fontconfig.Edit{{Name: "user_preference_list", Mode: "assign", Values: []fontconfig.Expr{fontconfig.Bool(b)}}},
+	}
 }
 
 // GenFamilyPreferenceLists generates fontconfig fpl conf with user's explicit choices
-func GenFamilyPreferenceLists(userMode bool, cfg sysconfig.Config) {
+func GenFamilyPreferenceLists(w Writer, userMode bool, cfg sysconfig.Settings) error {
 	fplFile := GetFcConfig("fpl", userMode)
-	Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("Generating %s", fplFile))
+	Dbg(cfg.Verbosity, Debug, fmt.Sprintf("Generating %s", fplFile))
 
-	fplText := genFcPreamble(userMode, "")
+	doc := newFcDocument(userMode)
 
 	if userMode {
-		fplText += "\t<match target=\"pattern\">\n\t\t<edit name=\"user_preference_list\" mode=\"assign\">\n" +
-			"\t\t\t<bool>true</bool>\n\t\t</edit>\n\t</match>\n"
+		doc.Append(userPreferenceList(true))
 	} else {
-		fplText += "\t<!-- Let user override here defined system setting. -->\n" +
-			"\t<match target=\"pattern\">\n\t\t<edit name=\"user_preference_list\" mode=\"assign\">\n" +
-			"\t\t\t<bool>false</bool>\n\t\t</edit>\n\t</match>\n" +
-			"\t<include ignore_missing=\"yes\" prefix=\"xdg\">fontconfig/family-prefer.conf</include>\n"
+		doc.Append(fontconfig.Comment(" Let user override here defined system setting. "),
+			userPreferenceList(false),
+			fontconfig.Include{IgnoreMissing: "yes", Prefix: "xdg", Path: "fontconfig/family-prefer.conf"})
 	}
 
-	fplText += "\n"
-	fplText += buildFPL("sans-serif", cfg.String("PREFER_SANS_FAMILIES"), userMode, cfg)
-	fplText += buildFPL("serif", cfg.String("PREFER_SERIF_FAMILIES"), userMode, cfg)
-	fplText += buildFPL("monospace", cfg.String("PREFER_MONO_FAMILIES"), userMode, cfg)
-	fplText += FcSuffix
+	doc.Append(buildFPL("sans-serif", cfg.PreferSansFamilies, userMode, cfg)...)
+	doc.Append(buildFPL("serif", cfg.PreferSerifFamilies, userMode, cfg)...)
+	doc.Append(buildFPL("monospace", cfg.PreferMonoFamilies, userMode, cfg)...)
 
-	Dbg(cfg.Int("VERBOSITY"), Debug, fmt.Sprintf("Writing %s.", fplFile))
+	Dbg(cfg.Verbosity, Debug, fmt.Sprintf("Writing %s.", fplFile))
 
-	err := overwriteOrRemoveFile(fplFile, []byte(fplText))
-	if err != nil {
-		log.Fatalf("Can not write %s: %s\n", fplFile, err.Error())
-	}
+	return writeFcDocument(w, fplFile, doc)
 }
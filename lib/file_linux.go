@@ -0,0 +1,39 @@
+//go:build linux
+// +build linux
+
+package lib
+
+import (
+	"os"
+	"syscall"
+)
+
+// selinuxXattr the extended attribute holding a file's SELinux label
+const selinuxXattr string = "security.selinux"
+
+// fileOwner get the uid and gid of a file
+func fileOwner(info os.FileInfo) (int, int) {
+	if st, ok := info.Sys().(*syscall.Stat_t); ok {
+		return int(st.Uid), int(st.Gid)
+	}
+	return -1, -1
+}
+
+// getSecurityLabel get the SELinux label of path, nil if there's none
+func getSecurityLabel(path string) []byte {
+	size, err := syscall.Getxattr(path, selinuxXattr, nil)
+	if err != nil || size <= 0 {
+		return nil
+	}
+	label := make([]byte, size)
+	size, err = syscall.Getxattr(path, selinuxXattr, label)
+	if err != nil {
+		return nil
+	}
+	return label[:size]
+}
+
+// setSecurityLabel set the SELinux label of path
+func setSecurityLabel(path string, label []byte) error {
+	return syscall.Setxattr(path, selinuxXattr, label, 0)
+}
@@ -0,0 +1,21 @@
+//go:build !linux
+// +build !linux
+
+package lib
+
+import "os"
+
+// fileOwner get the uid and gid of a file, unknown on this platform
+func fileOwner(info os.FileInfo) (int, int) {
+	return -1, -1
+}
+
+// getSecurityLabel SELinux labels are not supported on this platform
+func getSecurityLabel(path string) []byte {
+	return nil
+}
+
+// setSecurityLabel SELinux labels are not supported on this platform
+func setSecurityLabel(path string, label []byte) error {
+	return nil
+}
@@ -1,48 +1,80 @@
 package lib
 
 import (
-	"strconv"
+	"fmt"
 
 	ft "github.com/marguerite/fonts-config-ng/font"
+	"github.com/marguerite/fonts-config-ng/fontconfig"
 )
 
-// genFcPreamble generate fontconfig preamble
-func genFcPreamble(userMode bool, comment string) string {
-	cfg := "<?xml version=\"1.0\"?>\n<!DOCTYPE fontconfig SYSTEM \"fonts.dtd\">\n\n<!-- DO NOT EDIT; this is a generated file -->\n<!-- modify "
-	cfg += "/etc/sysconfig/fonts-config && run /usr/bin/fonts-config "
+// newFcDocument initialize a generated fontconfig document with the preamble
+func newFcDocument(userMode bool, comments ...string) *fontconfig.Document {
+	modify := " modify /etc/sysconfig/fonts-config && run /usr/bin/fonts-config "
 	if userMode {
-		cfg += "-\\-user "
+		modify += "-\\-user "
 	}
-	cfg += "instead. -->\n"
-	cfg += comment
-	cfg += "\n<fontconfig>\n\n"
-	return cfg
+	modify += "instead. "
+	return &fontconfig.Document{Comments: append([]string{" DO NOT EDIT; this is a generated file ", modify}, comments...)}
 }
 
-func genBlacklistConfig(b Blacklist) string {
-	cfg := "\t<match target=\"scan\">\n\t\t<test name=\"family\">\n\t\t\t<string>" + b.Name + "</string>\n\t\t</test>\n"
-	cfg += "\t\t<edit name=\"charset\" mode=\"assign_replace\">\n\t\t\t<minus>\n\t\t\t\t<name>charset</name>\n\t\t\t\t<charset>\n"
-	for _, v := range b.Charset {
-		if v.Min != v.Max {
-			cfg += "\t\t\t\t\t<range>\n"
-			cfg += "\t\t\t\t\t\t<int>0x" + strconv.FormatUint(v.Min, 16) + "</int>\n"
-			cfg += "\t\t\t\t\t\t<int>0x" + strconv.FormatUint(v.Max, 16) + "</int>\n"
-			cfg += "\t\t\t\t\t</range>\n"
-		} else {
-			cfg += "\t\t\t\t\t<int>0x" + strconv.FormatUint(v.Min, 16) + "</int>\n"
+// writeFcDocument validate and serialize doc and write it to path through w, a nil doc removes path
+func writeFcDocument(w Writer, path string, doc *fontconfig.Document) error {
+	var b []byte
+	if doc != nil {
+		err := fontconfig.Validate(doc)
+		if err != nil {
+			return fmt.Errorf("refusing to install invalid %s: %s", path, err.Error())
 		}
+		b, err = doc.Marshal()
+		if err != nil {
+			return fmt.Errorf("can not generate %s: %s", path, err.Error())
+		}
+	}
+	err := w.WriteFile(path, b)
+	if err != nil {
+		return fmt.Errorf("can not write %s: %s", path, err.Error())
+	}
+	return nil
+}
+
+// familyTest test the family of pattern or font
+func familyTest(family, compare string) fontconfig.Test {
+	return fontconfig.Test{Name: "family", Compare: compare, Values: []fontconfig.Expr{fontconfig.String(family)}}
+}
+
+// langTest test the lang of pattern or font
+func langTest(lang, compare string) fontconfig.Test {
+	return fontconfig.Test{Name: "lang", Compare: compare, Values: []fontconfig.Expr{fontconfig.String(lang)}}
+}
+
+// familyEdit edit the family of pattern with families
+func familyEdit(mode, binding string, families ...string) fontconfig.Edit {
+	edit := fontconfig.Edit{Name: "family", Mode: mode, Binding: binding}
+	for _, f := range families {
+		edit.Values = append(edit.Values, fontconfig.String(f))
+	}
+	return edit
+}
+
+func genBlacklistConfig(b Blacklist) fontconfig.Match {
+	return fontconfig.Match{
+		Target: "scan",
+		Tests:  []fontconfig.Test{familyTest(b.Name, "")},
+		Edits: []fontconfig.Edit{{Name: "charset", Mode: "assign_replace",
+			Values: []fontconfig.Expr{fontconfig.Op{Name: "minus", Args: []fontconfig.Expr{fontconfig.Name("charset"), fontconfig.Charset(b.Charset)}}}}},
 	}
-	cfg += "\t\t\t\t</charset>\n\t\t\t</minus>\n\t\t</edit>\n\t</match>\n\n"
-	return cfg
 }
 
-func genDualAisanConfig(font ft.Font) (cfg string) {
+func genDualAisanConfig(font ft.Font) (nodes []fontconfig.Node) {
 	for _, name := range font.Name {
-		cfg += "\t<match target=\"font\">\n\t\t<test name=\"family\" compare=\"contains\">\n\t\t\t<string>"
-		cfg += name
-		cfg += "</string>\n\t\t</test>\n"
-		cfg += "\t\t<edit name=\"spacing\" mode=\"append\">\n\t\t\t<const>proportional</const>\n\t\t</edit>\n"
-		cfg += "\t\t<edit name=\"globaladvance\" mode=\"append\">\n\t\t\t<bool>false</bool>\n\t\t</edit>\n\t</match>\n\n"
+		nodes = append(nodes, fontconfig.Match{
+			Target: "font",
+			Tests:  []fontconfig.Test{familyTest(name, "contains")},
+			Edits: []fontconfig.Edit{
+				{Name: "spacing", Mode: "append", Values: []fontconfig.Expr{fontconfig.Const("proportional")}},
+				{Name: "globaladvance", Mode: "append", Values: []fontconfig.Expr{fontconfig.Bool(false)}},
+			},
+		})
 	}
-	return cfg
+	return nodes
 }
@@ -0,0 +1,249 @@
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/marguerite/fonts-config-ng/sysconfig"
+)
+
+// HistoryLimit how many runs are kept in the history
+const HistoryLimit int = 10
+
+// Run one invocation of fonts-config: when it happened, the effective sysconfig values and every file written
+type Run struct {
+	Time     time.Time
+	UserMode bool
+	Config   map[string]string
+	Files    []RunFile
+	// Restored the time of the run a rollback restored, nil for a regular run
+	Restored *time.Time `json:",omitempty"`
+}
+
+// IsRollback whether the run was a rollback to an earlier run
+func (r Run) IsRollback() bool {
+	return r.Restored != nil
+}
+
+// generations the regular runs, newest first, the ones rollback and history count
+func generations(runs []Run) []Run {
+	var gens []Run
+	for _, run := range runs {
+		if !run.IsRollback() {
+			gens = append(gens, run)
+		}
+	}
+	return gens
+}
+
+// DefaultRollback the run rollback restores without N: the one before the run currently in effect,
+// so rolling back again goes further back
+func DefaultRollback(runs []Run) int {
+	if len(runs) == 0 || !runs[0].IsRollback() {
+		return 1
+	}
+	for i, run := range generations(runs) {
+		if run.Time.Equal(*runs[0].Restored) {
+			return i + 1
+		}
+	}
+	return 1
+}
+
+// RunFile a file written by a run, empty content means the file was removed.
+// Path is relative to Root.
+type RunFile struct {
+	Path    string
+	Content []byte
+	// Previous the content before the run wrote it, empty if it did not exist
+	Previous []byte `json:",omitempty"`
+}
+
+// historyDir the directory keeping the history of runs
+func historyDir(userMode bool) string {
+	if userMode {
+		data := os.Getenv("XDG_DATA_HOME")
+		if len(data) == 0 {
+			data = filepath.Join(os.Getenv("HOME"), ".local/share")
+		}
+		return RootPath(filepath.Join(data, "fonts-config/history"))
+	}
+	return RootPath("/var/lib/fonts-config/history")
+}
+
+// NewRun build a Run from the files recorded in w and the effective configuration
+func NewRun(w *MemoryWriter, cfg sysconfig.Config, userMode bool) Run {
+	run := Run{Time: time.Now(), UserMode: userMode, Config: make(map[string]string)}
+	for k, v := range cfg {
+		run.Config[k] = v
+	}
+	for _, path := range w.Paths {
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			rel = path
+		}
+		run.Files = append(run.Files, RunFile{filepath.Join("/", rel), w.Files[path], w.Previous[path]})
+	}
+	return run
+}
+
+// SaveRun append run to the history and drop the runs exceeding HistoryLimit
+func SaveRun(run Run) error {
+	dir := historyDir(run.UserMode)
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(run, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	name := run.Time.UTC().Format("20060102T150405.000000000") + ".json"
+	err = overwriteOrRemoveFile(filepath.Join(dir, name), b)
+	if err != nil {
+		return err
+	}
+
+	files, err := historyFiles(dir)
+	if err != nil {
+		return err
+	}
+	for len(files) > HistoryLimit {
+		err = os.Remove(files[len(files)-1])
+		if err != nil {
+			return fmt.Errorf("can not drop old run %s: %s", files[len(files)-1], err.Error())
+		}
+		files = files[:len(files)-1]
+	}
+	return nil
+}
+
+// historyFiles the run files in dir, newest first
+func historyFiles(dir string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return files, err
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(files)))
+	return files, nil
+}
+
+// LoadHistory load the recorded runs, newest first
+func LoadHistory(userMode bool) ([]Run, error) {
+	var runs []Run
+
+	files, err := historyFiles(historyDir(userMode))
+	if err != nil {
+		return runs, err
+	}
+
+	for _, f := range files {
+		b, err := ioutil.ReadFile(f)
+		if err != nil {
+			return runs, err
+		}
+		var run Run
+		err = json.Unmarshal(b, &run)
+		if err != nil {
+			return runs, fmt.Errorf("broken history file %s: %s", f, err.Error())
+		}
+		runs = append(runs, run)
+	}
+
+	return runs, nil
+}
+
+// FormatHistory describe every run, newest first, with the settings changed since the run before it.
+// regular runs are numbered like RollbackTo counts them, rollbacks are not.
+func FormatHistory(runs []Run) string {
+	var str string
+	n := 0
+	for i, run := range runs {
+		if run.IsRollback() {
+			str += fmt.Sprintf("  -  %s  rollback to the run of %s, %d files\n", run.Time.Local().Format("2006-01-02 15:04:05"),
+				run.Restored.Local().Format("2006-01-02 15:04:05"), len(run.Files))
+			continue
+		}
+		str += fmt.Sprintf("%3d  %s  %d files\n", n, run.Time.Local().Format("2006-01-02 15:04:05"), len(run.Files))
+		n++
+
+		prev := map[string]string{}
+		for _, r := range runs[i+1:] {
+			if !r.IsRollback() {
+				prev = r.Config
+				break
+			}
+		}
+		keys := make([]string, 0, len(run.Config))
+		for k := range run.Config {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if v, ok := prev[k]; !ok || v != run.Config[k] {
+				str += fmt.Sprintf("       %s=\"%s\"\n", k, run.Config[k])
+			}
+		}
+	}
+	return str
+}
+
+// RollbackTo rewrite every file recorded in the history to its state after the regular run n (0 is
+// the latest, rollbacks are not counted). a file not written by run n gets the content of the latest
+// run before it which wrote it, a file only written by runs after it gets the content it had before the
+// first of them, eg. the sysconfig file changed by set, or is removed if it did not exist then.
+// returns the restored run.
+func RollbackTo(w Writer, runs []Run, n int) (Run, error) {
+	gens := generations(runs)
+	if n < 0 || n >= len(gens) {
+		return Run{}, fmt.Errorf("no run %d in history, only %d runs recorded", n, len(gens))
+	}
+	target := gens[n]
+
+	restored := map[string]struct{}{}
+	for _, run := range gens[n:] {
+		for _, f := range run.Files {
+			if _, ok := restored[f.Path]; ok {
+				continue
+			}
+			restored[f.Path] = struct{}{}
+			err := w.WriteFile(RootPath(f.Path), f.Content)
+			if err != nil {
+				return target, fmt.Errorf("can not restore %s from run %d: %s", f.Path, n, err.Error())
+			}
+		}
+	}
+
+	// files only written after run n, runs are newest first so the earliest write wins
+	var later []string
+	before := map[string][]byte{}
+	for _, run := range runs {
+		if !run.Time.After(target.Time) {
+			break
+		}
+		for _, f := range run.Files {
+			if _, ok := restored[f.Path]; ok {
+				continue
+			}
+			if _, ok := before[f.Path]; !ok {
+				later = append(later, f.Path)
+			}
+			before[f.Path] = f.Previous
+		}
+	}
+	for _, path := range later {
+		err := w.WriteFile(RootPath(path), before[path])
+		if err != nil {
+			return target, fmt.Errorf("can not restore %s written after run %d: %s", path, n, err.Error())
+		}
+	}
+
+	return target, nil
+}
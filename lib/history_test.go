@@ -0,0 +1,145 @@
+package lib
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testRuns three regular runs, newest first: the latest one added a blacklist and changed the rendering
+func testRuns() []Run {
+	t0 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	return []Run{
+		{Time: t0.Add(2 * time.Hour), Config: map[string]string{"FORCE_BW": "yes"}, Files: []RunFile{
+			{"/etc/fonts/conf.d/10-rendering-options.conf", []byte("bw"), nil},
+			{"/etc/fonts/conf.d/81-emoji-blacklist-glyphs.conf", []byte("blacklist"), nil},
+		}},
+		{Time: t0.Add(time.Hour), Config: map[string]string{"FORCE_BW": "no"}, Files: []RunFile{
+			{"/etc/fonts/conf.d/10-rendering-options.conf", []byte("gray"), nil},
+		}},
+		{Time: t0, Config: map[string]string{"FORCE_BW": "no"}, Files: []RunFile{
+			{"/etc/fonts/conf.d/10-rendering-options.conf", []byte("first"), nil},
+			{"/etc/fonts/conf.d/58-family-prefer-local.conf", []byte("prefer"), nil},
+		}},
+	}
+}
+
+func TestRollbackTo(t *testing.T) {
+	tests := []struct {
+		n    int
+		want map[string]string
+	}{
+		{0, map[string]string{
+			"/etc/fonts/conf.d/10-rendering-options.conf":      "bw",
+			"/etc/fonts/conf.d/81-emoji-blacklist-glyphs.conf": "blacklist",
+			"/etc/fonts/conf.d/58-family-prefer-local.conf":    "prefer",
+		}},
+		{1, map[string]string{
+			"/etc/fonts/conf.d/10-rendering-options.conf":      "gray",
+			"/etc/fonts/conf.d/81-emoji-blacklist-glyphs.conf": "",
+			"/etc/fonts/conf.d/58-family-prefer-local.conf":    "prefer",
+		}},
+		{2, map[string]string{
+			"/etc/fonts/conf.d/10-rendering-options.conf":      "first",
+			"/etc/fonts/conf.d/81-emoji-blacklist-glyphs.conf": "",
+			"/etc/fonts/conf.d/58-family-prefer-local.conf":    "prefer",
+		}},
+	}
+	for _, tt := range tests {
+		w := NewMemoryWriter()
+		if _, err := RollbackTo(w, testRuns(), tt.n); err != nil {
+			t.Fatalf("run %d: %s", tt.n, err)
+		}
+		if len(w.Files) != len(tt.want) {
+			t.Errorf("run %d: wrote %v", tt.n, w.Paths)
+		}
+		for path, content := range tt.want {
+			got, ok := w.Files[RootPath(path)]
+			if !ok || string(got) != content {
+				t.Errorf("run %d: %s = %q, want %q", tt.n, path, got, content)
+			}
+		}
+	}
+
+	if _, err := RollbackTo(NewMemoryWriter(), testRuns(), 3); err == nil {
+		t.Error("rolled back to a run not in history")
+	}
+}
+
+func TestRollbackSkipsRollbacks(t *testing.T) {
+	runs := testRuns()
+	if n := DefaultRollback(runs); n != 1 {
+		t.Errorf("DefaultRollback() = %d, want 1", n)
+	}
+
+	// roll back to run 1, twice
+	for want := 1; want <= 2; want++ {
+		n := DefaultRollback(runs)
+		if n != want {
+			t.Fatalf("DefaultRollback() = %d, want %d", n, want)
+		}
+		w := NewMemoryWriter()
+		run, err := RollbackTo(w, runs, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rollback := NewRun(w, nil, false)
+		rollback.Time = runs[0].Time.Add(time.Minute)
+		rollback.Restored = &run.Time
+		runs = append([]Run{rollback}, runs...)
+	}
+
+	if got := string(runs[0].Files[0].Content); got != "first" {
+		t.Errorf("second rollback restored %q, want %q", got, "first")
+	}
+
+	history := FormatHistory(runs)
+	if strings.Count(history, "rollback to the run of") != 2 || !strings.Contains(history, "  2  ") {
+		t.Errorf("rollbacks are numbered in\n%s", history)
+	}
+}
+
+func TestRollbackRestoresFilesBeforeHistory(t *testing.T) {
+	setTestRoot(t, map[string]string{
+		SysconfigFile: "FORCE_BW=\"no\"\n",
+	})
+	rendering := RootPath("/etc/fonts/conf.d/10-rendering-options.conf")
+	blacklist := RootPath("/etc/fonts/conf.d/81-emoji-blacklist-glyphs.conf")
+
+	// a regular run, then set changing the sysconfig file the history has not seen before
+	var runs []Run
+	t0 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, files := range []map[string]string{
+		{rendering: "gray"},
+		{RootPath(SysconfigFile): "FORCE_BW=\"yes\"\n", rendering: "bw", blacklist: "blacklist"},
+	} {
+		record := NewMemoryWriter()
+		w := RecordingWriter{Writer: FileWriter{}, Record: record}
+		for _, path := range []string{RootPath(SysconfigFile), rendering, blacklist} {
+			if content, ok := files[path]; ok {
+				if err := w.WriteFile(path, []byte(content)); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+		run := NewRun(record, nil, false)
+		run.Time = t0.Add(time.Duration(i) * time.Hour)
+		runs = append([]Run{run}, runs...)
+	}
+
+	if _, err := RollbackTo(FileWriter{}, runs, 1); err != nil {
+		t.Fatal(err)
+	}
+	for path, want := range map[string]string{
+		RootPath(SysconfigFile): "FORCE_BW=\"no\"\n",
+		rendering:               "gray",
+	} {
+		if b, err := os.ReadFile(path); err != nil || string(b) != want {
+			t.Errorf("%s = %q, %v, want %q", path, b, err, want)
+		}
+	}
+	if _, err := os.Stat(blacklist); !os.IsNotExist(err) {
+		t.Errorf("%s created by the later run is kept", blacklist)
+	}
+}
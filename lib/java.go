@@ -1,8 +1,8 @@
 package lib
 
 import (
+	"bytes"
 	"fmt"
-	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
@@ -106,17 +106,17 @@ func getJavaXLFD(name string) string {
 }
 
 // GenerateJavaFontSetup generates fontconfig properties conf for java
-func GenerateJavaFontSetup(c ft.Collection) error {
-	Dbg(cfg.Int("VERBOSITY"), Verbose, "Generating java font setup ...\n")
+func GenerateJavaFontSetup(w Writer, c ft.Collection, verbosity int) error {
+	Dbg(verbosity, Verbose, "Generating java font setup ...\n")
 
-	tmpl, err := template.ParseFiles("/usr/share/fonts-config/fontconfig.SUSE.properties.template")
+	tmpl, err := template.ParseFiles(RootPath("/usr/share/fonts-config/fontconfig.SUSE.properties.template"))
 	if err != nil {
-		panic(err)
+		return err
 	}
 
 	fonts := selectJavaFonts(c)
 
-	Dbg(cfg.Int("VERBOSITY"), Debug, func(fonts map[string]Java_XLFD) string {
+	Dbg(verbosity, Debug, func(fonts map[string]Java_XLFD) string {
 		var str string
 		for k, v := range fonts {
 			str += fmt.Sprintf("%s_file=%s\n", k, v.File)
@@ -126,20 +126,21 @@ func GenerateJavaFontSetup(c ft.Collection) error {
 		return str
 	}, fonts)
 
-	paths, err := dirutils.Glob("/usr/lib*/jvm/*/jre/lib")
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, fonts)
+	if err != nil {
+		return err
+	}
+
+	paths, err := dirutils.Glob(RootPath("/usr/lib*/jvm/*/jre/lib"))
 	if err != nil {
 		return err
 	}
 
 	for _, path := range paths {
-		f, err := os.OpenFile(filepath.Join(path, "fontconfig.SUSE.properties"), os.O_RDWR|os.O_CREATE, 0755)
-		if err != nil {
-			panic(err)
-		}
-		defer f.Close()
-		err = tmpl.Execute(f, fonts)
+		err = w.WriteFile(filepath.Join(path, "fontconfig.SUSE.properties"), buf.Bytes())
 		if err != nil {
-			panic(err)
+			return err
 		}
 	}
 
@@ -0,0 +1,420 @@
+package lib
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	ft "github.com/marguerite/fonts-config-ng/font"
+	"github.com/marguerite/fonts-config-ng/fontconfig"
+	"github.com/marguerite/go-stdlib/slice"
+)
+
+// LintProblem a problem found in an installed configuration file
+type LintProblem struct {
+	File    string
+	Line    int
+	Message string
+}
+
+func (p LintProblem) String() string {
+	if p.Line > 0 {
+		return fmt.Sprintf("%s:%d: %s", p.File, p.Line, p.Message)
+	}
+	return fmt.Sprintf("%s: %s", p.File, p.Message)
+}
+
+// confOrigin where an installed configuration file comes from
+type confOrigin int
+
+const (
+	// shipped a symlink into conf.avail installed by a package
+	shipped confOrigin = iota
+	// generated written by fonts-config
+	generated
+	// local dropped into conf.d by the admin
+	local
+)
+
+func (o confOrigin) String() string {
+	switch o {
+	case shipped:
+		return "shipped"
+	case generated:
+		return "generated"
+	}
+	return "local"
+}
+
+// getConfOrigin tell where the parsed configuration file at path comes from
+func getConfOrigin(path string, doc *fontconfig.Document) confOrigin {
+	for _, c := range doc.Comments {
+		if strings.Contains(c, "DO NOT EDIT; this is a generated file") {
+			return generated
+		}
+	}
+	if target, err := os.Readlink(path); err == nil && strings.Contains(target, "conf.avail") {
+		return shipped
+	}
+	return local
+}
+
+// confFile a parsed file of conf.d
+type confFile struct {
+	path   string
+	origin confOrigin
+	doc    *fontconfig.Document
+}
+
+// genericFamilies the generic family names fontconfig resolves itself
+var genericFamilies = []string{"sans-serif", "serif", "monospace", "emoji", "math", "cursive", "fantasy", "system-ui"}
+
+// layoutRange a numeric range of the conf.d layout described in README
+type layoutRange struct {
+	min, max int
+}
+
+// renderingProperties the pattern elements adjusted by rendering option rules
+var renderingProperties = []string{"antialias", "hinting", "hintstyle", "autohint", "rgba", "lcdfilter", "embeddedbitmap"}
+
+// synthesisProperties the pattern elements adjusted by font synthesis rules
+var synthesisProperties = []string{"embolden", "matrix"}
+
+// confLoader parse configuration files in the order fontconfig loads them, following their <include>s
+type confLoader struct {
+	userMode bool
+	visited  map[string]bool
+	files    []confFile
+	// broken the problems of the files that don't parse
+	broken []LintProblem
+	// dangling the dangling symlinks, fontconfig ignores them
+	dangling []LintProblem
+}
+
+// load parse the file at path, then the files its <include>s refer to
+func (l *confLoader) load(path string) {
+	if l.visited[path] {
+		return
+	}
+	l.visited[path] = true
+
+	doc, err := fontconfig.ParseFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			if target, err := os.Readlink(path); err == nil {
+				l.dangling = append(l.dangling, LintProblem{path, 0, fmt.Sprintf("dangling symlink to %s", target)})
+			}
+			return
+		}
+		l.broken = append(l.broken, LintProblem{path, 0, err.Error()})
+		return
+	}
+	l.files = append(l.files, confFile{path, getConfOrigin(path, doc), doc})
+
+	for _, n := range doc.Nodes {
+		if inc, ok := n.(fontconfig.Include); ok {
+			for _, f := range fontconfig.ResolveInclude(inc, path, root, l.userMode) {
+				l.load(f)
+			}
+		}
+	}
+}
+
+// loadConfFiles parse the .conf files of conf.d and the files they include in the order fontconfig
+// loads them. in user mode the user's configuration layered on conf.d is followed too, and
+// fonts-config's files in ~/.config/fontconfig no <include> refers to are appended.
+func loadConfFiles(userMode bool) *confLoader {
+	l := &confLoader{userMode: userMode, visited: make(map[string]bool)}
+
+	files, _ := filepath.Glob(filepath.Join(RootPath("/etc/fonts/conf.d"), "*.conf"))
+	sort.Strings(files)
+	for _, f := range files {
+		l.load(f)
+	}
+
+	if userMode {
+		files, _ = filepath.Glob(filepath.Join(RootPath(configHome()), "fontconfig", "*.conf"))
+		sort.Strings(files)
+		for _, f := range files {
+			l.load(f)
+		}
+	}
+
+	return l
+}
+
+// sameTests whether a and b test the same things, regardless of where they are written
+func sameTests(a, b []fontconfig.Test) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		x, y := a[i], b[i]
+		x.Line, y.Line = 0, 0
+		if !reflect.DeepEqual(x, y) {
+			return false
+		}
+	}
+	return true
+}
+
+// testsIncluded whether every test of a is also in b, so a rule testing a applies whenever one testing b does
+func testsIncluded(a, b []fontconfig.Test) bool {
+	for _, x := range a {
+		found := false
+		for _, y := range b {
+			if sameTests([]fontconfig.Test{x}, []fontconfig.Test{y}) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// overrides whether edit replaces every value of its element in a match with tests
+func overrides(edit fontconfig.Edit, tests []fontconfig.Test) bool {
+	if edit.Binding != "strong" {
+		return false
+	}
+	if edit.Mode == "assign_replace" {
+		return true
+	}
+	if edit.Mode != "assign" && edit.Mode != "" {
+		return false
+	}
+	// assign only replaces the matched value if the element was tested
+	for _, t := range tests {
+		if t.Name == edit.Name {
+			return false
+		}
+	}
+	return true
+}
+
+// lintShadowed report edits overridden by a strong assignment in a later file applying in the same cases
+func lintShadowed(files []confFile) []LintProblem {
+	var problems []LintProblem
+	for i, f := range files {
+		for _, n := range f.doc.Nodes {
+			m, ok := n.(fontconfig.Match)
+			if !ok {
+				continue
+			}
+			for _, e := range m.Edits {
+				for _, later := range files[i+1:] {
+					if by, ok := shadowedBy(m, e, later); ok {
+						problems = append(problems, LintProblem{f.path, e.Line,
+							fmt.Sprintf("edit of %s is shadowed by the strong binding at %s:%d", e.Name, later.path, by.Line)})
+						break
+					}
+				}
+			}
+		}
+	}
+	return problems
+}
+
+// shadowedBy find the edit in later overriding e of m
+func shadowedBy(m fontconfig.Match, e fontconfig.Edit, later confFile) (fontconfig.Edit, bool) {
+	for _, n := range later.doc.Nodes {
+		m1, ok := n.(fontconfig.Match)
+		if !ok || m1.Target != m.Target || !testsIncluded(m1.Tests, m.Tests) {
+			continue
+		}
+		for _, e1 := range m1.Edits {
+			if e1.Name == e.Name && overrides(e1, m1.Tests) {
+				return e1, true
+			}
+		}
+	}
+	return fontconfig.Edit{}, false
+}
+
+// preferredFamilies the families an alias or a family edit would prefer
+func preferredFamilies(n fontconfig.Node) ([]string, int) {
+	var families []string
+	switch t := n.(type) {
+	case fontconfig.Alias:
+		for _, l := range []*fontconfig.FamilyList{t.Prefer, t.Accept, t.Default} {
+			if l != nil {
+				families = append(families, l.Families...)
+			}
+		}
+		return families, t.Line
+	case fontconfig.Match:
+		for _, e := range t.Edits {
+			if e.Name != "family" {
+				continue
+			}
+			for _, v := range e.Values {
+				if s, ok := v.(fontconfig.String); ok {
+					families = append(families, string(s))
+				}
+			}
+		}
+		return families, t.Line
+	}
+	return families, 0
+}
+
+// aliasLists the files fonts-config generates from fontconfig's or its own lists of well-known families
+var aliasLists = []string{"30-metric-aliases.conf", "59-family-prefer-lang-specific-cjk.conf", "family-prefer-lang-specific-cjk.conf"}
+
+// lintMissingFamilies report families preferred by generated and local files but not installed.
+// shipped files and the generated alias lists name every well-known family on purpose.
+func lintMissingFamilies(files []confFile, c ft.Collection) []LintProblem {
+	var problems []LintProblem
+	for _, f := range files {
+		if list, _ := slice.Contains(aliasLists, filepath.Base(f.path)); list || f.origin == shipped {
+			continue
+		}
+		for _, n := range f.doc.Nodes {
+			families, line := preferredFamilies(n)
+			for _, family := range families {
+				if ok, _ := slice.Contains(genericFamilies, family); ok || len(c.ByFamily(family)) > 0 {
+					continue
+				}
+				problems = append(problems, LintProblem{f.path, line, fmt.Sprintf("family %s is not installed", family)})
+			}
+		}
+	}
+	return problems
+}
+
+// lintDuplicateAliases report aliases generated or dropped locally for a family already aliased with the same tests
+func lintDuplicateAliases(files []confFile) []LintProblem {
+	var problems []LintProblem
+	type seenAlias struct {
+		path   string
+		origin confOrigin
+		alias  fontconfig.Alias
+	}
+	seen := make(map[string][]seenAlias)
+
+	for _, f := range files {
+		for _, n := range f.doc.Nodes {
+			a, ok := n.(fontconfig.Alias)
+			if !ok {
+				continue
+			}
+			for _, family := range a.Family {
+				for _, s := range seen[family] {
+					// shipped files extend each other's aliases on purpose
+					if s.origin == shipped && f.origin == shipped {
+						continue
+					}
+					if sameTests(s.alias.Tests, a.Tests) {
+						problems = append(problems, LintProblem{f.path, a.Line,
+							fmt.Sprintf("duplicate alias for %s, already at %s:%d", family, s.path, s.alias.Line)})
+						break
+					}
+				}
+				seen[family] = append(seen[family], seenAlias{f.path, f.origin, a})
+			}
+		}
+	}
+	return problems
+}
+
+// contentRanges the layout ranges the content of doc belongs to
+func contentRanges(doc *fontconfig.Document) []layoutRange {
+	var ranges []layoutRange
+	for _, n := range doc.Nodes {
+		switch t := n.(type) {
+		case fontconfig.Element:
+			if t.XMLName.Local == "dir" || t.XMLName.Local == "cachedir" || t.XMLName.Local == "reset-dirs" || t.XMLName.Local == "remap-dir" {
+				ranges = append(ranges, layoutRange{0, 9})
+			}
+		case fontconfig.Include:
+			ranges = append(ranges, layoutRange{50, 59})
+		case fontconfig.SelectFont:
+			ranges = append(ranges, layoutRange{70, 79})
+		case fontconfig.Alias:
+			ranges = append(ranges, layoutRange{30, 69})
+		case fontconfig.Match:
+			if t.Target == "scan" {
+				ranges = append(ranges, layoutRange{80, 89})
+				continue
+			}
+			for _, e := range t.Edits {
+				rendering, _ := slice.Contains(renderingProperties, e.Name)
+				synthesis, _ := slice.Contains(synthesisProperties, e.Name)
+				switch {
+				case rendering:
+					ranges = append(ranges, layoutRange{10, 29})
+				case synthesis:
+					ranges = append(ranges, layoutRange{90, 99})
+				case e.Name == "family":
+					ranges = append(ranges, layoutRange{30, 69})
+				}
+			}
+		}
+	}
+	return ranges
+}
+
+// lintLayout report generated and local files of a conf.d whose number is outside every range of the README layout
+// their content belongs to
+func lintLayout(files []confFile) []LintProblem {
+	var problems []LintProblem
+	for _, f := range files {
+		if f.origin == shipped || filepath.Base(filepath.Dir(f.path)) != "conf.d" {
+			continue
+		}
+		ranges := contentRanges(f.doc)
+		if len(ranges) == 0 {
+			continue
+		}
+		base := filepath.Base(f.path)
+		if len(base) < 3 || base[2] != '-' {
+			problems = append(problems, LintProblem{f.path, 0, "file name does not start with a two digit priority"})
+			continue
+		}
+		num, err := strconv.Atoi(base[:2])
+		if err != nil {
+			problems = append(problems, LintProblem{f.path, 0, "file name does not start with a two digit priority"})
+			continue
+		}
+		ok := false
+		var expected []string
+		for _, r := range ranges {
+			if num >= r.min && num <= r.max {
+				ok = true
+				break
+			}
+			s := fmt.Sprintf("%02d-%02d", r.min, r.max)
+			if listed, _ := slice.Contains(expected, s); !listed {
+				expected = append(expected, s)
+			}
+		}
+		if !ok {
+			problems = append(problems, LintProblem{f.path, 0,
+				fmt.Sprintf("content belongs to range %s of the layout", strings.Join(expected, " or "))})
+		}
+	}
+	return problems
+}
+
+// LintConfDir load every configuration file in the order fontconfig does and report shadowed rules, families
+// not installed in c, duplicate aliases, files out of the numbered layout and dangling symlinks.
+func LintConfDir(c ft.Collection, userMode bool) ([]LintProblem, error) {
+	l := loadConfFiles(userMode)
+	files := l.files
+	problems := append(append([]LintProblem{}, l.dangling...), l.broken...)
+
+	problems = append(problems, lintShadowed(files)...)
+	problems = append(problems, lintMissingFamilies(files, c)...)
+	problems = append(problems, lintDuplicateAliases(files)...)
+	problems = append(problems, lintLayout(files)...)
+
+	sort.SliceStable(problems, func(i, j int) bool { return problems[i].File < problems[j].File })
+	return problems, nil
+}
@@ -0,0 +1,122 @@
+package lib
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ft "github.com/marguerite/fonts-config-ng/font"
+)
+
+// setTestRoot point root at a new directory holding files, relative paths to contents, for the duration of the test
+func setTestRoot(t *testing.T, files map[string]string) {
+	dir := t.TempDir()
+	for path, content := range files {
+		path = filepath.Join(dir, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	old := root
+	root = dir
+	t.Cleanup(func() { root = old })
+	t.Setenv("HOME", "/home/user")
+	t.Setenv("XDG_CONFIG_HOME", "")
+}
+
+const generatedComment = "<!-- DO NOT EDIT; this is a generated file -->\n"
+
+// lintRoot a system configuration with a user configuration layered on it
+var lintRoot = map[string]string{
+	"etc/fonts/conf.d/30-metric-aliases.conf": generatedComment +
+		`<fontconfig><alias><family>Arial</family><accept><family>Liberation Sans</family></accept></alias></fontconfig>`,
+	"etc/fonts/conf.d/50-user.conf": `<fontconfig>
+	<include ignore_missing="yes" prefix="xdg">fontconfig/conf.d</include>
+	<include ignore_missing="yes" prefix="xdg">fontconfig/fonts.conf</include>
+	<include ignore_missing="yes">~/.fonts.conf.d</include>
+</fontconfig>`,
+	"etc/fonts/conf.d/60-local.conf": `<fontconfig>
+	<alias><family>sans-serif</family><prefer><family>dejavusans</family><family>DejaVu</family></prefer></alias>
+</fontconfig>`,
+	"etc/fonts/conf.d/59-family-prefer-lang-specific-cjk.conf": generatedComment +
+		`<fontconfig><alias><family>sans-serif</family><prefer><family>Noto Sans CJK SC</family></prefer></alias></fontconfig>`,
+	"home/user/.config/fontconfig/conf.d/65-mine.conf": `<fontconfig>
+	<alias><family>monospace</family><prefer><family>Hack</family></prefer></alias>
+</fontconfig>`,
+	"home/user/.config/fontconfig/family-prefer.conf": generatedComment +
+		`<fontconfig><alias><family>serif</family><prefer><family>Gentium</family></prefer></alias></fontconfig>`,
+	"home/user/.config/fontconfig/family-prefer-lang-specific-cjk.conf": generatedComment +
+		`<fontconfig><alias><family>serif</family><prefer><family>Noto Serif CJK SC</family></prefer></alias></fontconfig>`,
+	"home/user/.fonts.conf.d/10-old.conf": `<fontconfig><alias><family>serif</family><prefer><family>Old</family></prefer></alias></fontconfig>`,
+}
+
+func TestLoadConfFiles(t *testing.T) {
+	setTestRoot(t, lintRoot)
+	tests := []struct {
+		userMode bool
+		want     []string
+	}{
+		{false, []string{
+			"etc/fonts/conf.d/30-metric-aliases.conf",
+			"etc/fonts/conf.d/50-user.conf",
+			"etc/fonts/conf.d/59-family-prefer-lang-specific-cjk.conf",
+			"etc/fonts/conf.d/60-local.conf",
+		}},
+		{true, []string{
+			"etc/fonts/conf.d/30-metric-aliases.conf",
+			"etc/fonts/conf.d/50-user.conf",
+			"home/user/.config/fontconfig/conf.d/65-mine.conf",
+			"home/user/.fonts.conf.d/10-old.conf",
+			"etc/fonts/conf.d/59-family-prefer-lang-specific-cjk.conf",
+			"etc/fonts/conf.d/60-local.conf",
+			"home/user/.config/fontconfig/family-prefer-lang-specific-cjk.conf",
+			"home/user/.config/fontconfig/family-prefer.conf",
+		}},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, f := range loadConfFiles(tt.userMode).files {
+			rel, _ := filepath.Rel(root, f.path)
+			got = append(got, rel)
+		}
+		if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+			t.Errorf("user mode %t: loaded\n%s\nwant\n%s", tt.userMode, strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+		}
+	}
+}
+
+func TestLintMissingFamilies(t *testing.T) {
+	setTestRoot(t, lintRoot)
+	c := ft.Collection{{Name: []string{"DejaVu Sans"}}}
+
+	problems, err := LintConfDir(c, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, p := range problems {
+		if strings.Contains(p.Message, "is not installed") {
+			rel, _ := filepath.Rel(root, p.File)
+			got = append(got, rel+": "+p.Message)
+		}
+	}
+	want := []string{
+		"etc/fonts/conf.d/60-local.conf: family DejaVu is not installed",
+		"home/user/.config/fontconfig/conf.d/65-mine.conf: family Hack is not installed",
+		"home/user/.config/fontconfig/family-prefer.conf: family Gentium is not installed",
+		"home/user/.fonts.conf.d/10-old.conf: family Old is not installed",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	for _, p := range problems {
+		if strings.Contains(p.Message, "two digit priority") {
+			t.Errorf("layout checked outside conf.d: %s", p)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package lib
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	ft "github.com/marguerite/fonts-config-ng/font"
+)
+
+// ListFormats the output formats of ListFonts
+var ListFormats = []string{"table", "json", "csv"}
+
+// FontListing a font as printed by list-fonts
+type FontListing struct {
+	File      string   `json:"file"`
+	Index     int      `json:"index"`
+	Families  []string `json:"families"`
+	Style     string   `json:"style"`
+	Weight    int      `json:"weight"`
+	Spacing   string   `json:"spacing"`
+	Languages []string `json:"languages"`
+	Chars     int      `json:"chars"`
+}
+
+// spacingName the fontconfig constant of a spacing value
+func spacingName(spacing int) string {
+	switch spacing {
+	case 0:
+		return "proportional"
+	case 90:
+		return "dual"
+	case 100:
+		return "mono"
+	case 110:
+		return "charcell"
+	}
+	return strconv.Itoa(spacing)
+}
+
+// NewFontListing describe font for list-fonts
+func NewFontListing(font ft.Font) FontListing {
+	l := FontListing{File: font.File,
+		Index:     font.Index,
+		Families:  font.Name,
+		Weight:    font.Weight,
+		Spacing:   spacingName(font.Spacing),
+		Languages: font.Lang,
+		Chars:     font.Count(),
+	}
+	if len(font.Style) > 0 {
+		l.Style = font.Style[0]
+	}
+	// empty lists rather than null in JSON
+	if l.Families == nil {
+		l.Families = []string{}
+	}
+	if l.Languages == nil {
+		l.Languages = []string{}
+	}
+	return l
+}
+
+// row the columns of the listing for csv and table output
+func (l FontListing) row(familySep string) []string {
+	return []string{l.File, strconv.Itoa(l.Index), strings.Join(l.Families, familySep), l.Style,
+		strconv.Itoa(l.Weight), l.Spacing, strings.Join(l.Languages, "|"), strconv.Itoa(l.Chars)}
+}
+
+// ListFonts print the fonts of c to out in format, one of ListFormats
+func ListFonts(c ft.Collection, format string, out io.Writer) error {
+	header := []string{"FILE", "INDEX", "FAMILIES", "STYLE", "WEIGHT", "SPACING", "LANGUAGES", "CHARS"}
+
+	listings := make([]FontListing, 0, len(c))
+	for _, font := range c {
+		listings = append(listings, NewFontListing(font))
+	}
+
+	switch format {
+	case "json":
+		b, err := json.MarshalIndent(listings, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(b))
+		return err
+	case "csv":
+		w := csv.NewWriter(out)
+		w.Write(header)
+		for _, l := range listings {
+			w.Write(l.row(","))
+		}
+		w.Flush()
+		return w.Error()
+	case "table":
+		w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(w, strings.Join(header, "\t"))
+		for _, l := range listings {
+			fmt.Fprintln(w, strings.Join(l.row(", "), "\t"))
+		}
+		return w.Flush()
+	}
+	return fmt.Errorf("unknown format %s: must be one of %s", format, strings.Join(ListFormats, ", "))
+}
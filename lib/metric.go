@@ -1,47 +1,41 @@
 package lib
 
 import (
-	"bufio"
 	"fmt"
-	"io"
-	"log"
-	"strings"
 
-	"github.com/marguerite/go-stdlib/ioutils"
+	"github.com/marguerite/fonts-config-ng/fontconfig"
 )
 
-func mkMetricCompatibility(f io.Reader) string {
-	var metric string
-	scanner := bufio.NewScanner(f)
+// mkMetricCompatibility make every alias of fontconfig's metric aliases depend on search_metric_aliases
+func mkMetricCompatibility(avail *fontconfig.Document) *fontconfig.Document {
+	doc := newFcDocument(false, avail.Comments...)
+	test := fontconfig.Test{Name: "search_metric_aliases", Values: []fontconfig.Expr{fontconfig.Bool(true)}}
 
-	for scanner.Scan() {
-		line := scanner.Text()
-		metric += line + "\n"
-		if strings.Contains(line, "<alias ") {
-			metric += "\t  <test name=\"search_metric_aliases\"><bool>true</bool></test>\n"
-		}
-		if strings.Contains(line, "<!DOCTYPE ") {
-			metric += "\n<!-- DO NOT EDIT; this is a generated file -->\n<!-- modify /etc/sysconfig/fonts-config && run /usr/bin/fonts-config instead -->\n\n"
+	for _, n := range avail.Nodes {
+		if a, ok := n.(fontconfig.Alias); ok {
+			a.Tests = append([]fontconfig.Test{test}, a.Tests...)
+			n = a
 		}
+		doc.Append(n)
 	}
 
-	return metric
+	return doc
 }
 
 // GenMetricCompatibility generate 30-metric-aliases.conf
-func GenMetricCompatibility(verbosity int) {
+func GenMetricCompatibility(w Writer, verbosity int) error {
 	// replace fontconfig's /etc/fonts/conf.d/30-metric-aliases.conf
 	// by fonts-config's one
 
-	avail := "/usr/share/fontconfig/conf.avail/30-metric-aliases.conf"
-	file := "/etc/fonts/conf.d/30-metric-aliases.conf"
+	avail := RootPath("/usr/share/fontconfig/conf.avail/30-metric-aliases.conf")
+	file := RootPath("/etc/fonts/conf.d/30-metric-aliases.conf")
 
-	text := mkMetricCompatibility(ioutils.NewReaderFromFile(avail))
+	doc, err := fontconfig.ParseFile(avail)
+	if err != nil {
+		return err
+	}
 
 	Dbg(verbosity, Debug, fmt.Sprintf("Writing %s\n", file))
 
-	err := overwriteOrRemoveFile(file, []byte(text))
-	if err != nil {
-		log.Fatalf("Can not write %s: %s\n", file, err.Error())
-	}
+	return writeFcDocument(w, file, mkMetricCompatibility(doc))
 }
@@ -0,0 +1,100 @@
+package lib
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	"github.com/marguerite/fonts-config-ng/sysconfig"
+)
+
+// migrateFlag the setting asking for every default to be applied by the next migration
+const migrateFlag string = "FORCE_MODIFY_DEFAULT_FONT_SETTINGS_IN_NEXT_UPDATE"
+
+// DefaultsSnapshot a copy of the sysconfig template the installed settings were last migrated to,
+// so the next migration knows which values are still at an old default
+const DefaultsSnapshot string = "/var/lib/fonts-config/sysconfig.fonts-config.defaults"
+
+// Migrate bring /etc/sysconfig/fonts-config up to date with the sysconfig template through w:
+// values still at the previous default (or all values if FORCE_MODIFY_DEFAULT_FONT_SETTINGS_IN_NEXT_UPDATE
+// is set) take the new default, new variables are added and obsolete ones dropped.
+// returns a description of every change.
+func Migrate(w Writer) ([]string, error) {
+	var changes []string
+
+	template, err := ioutil.ReadFile(RootPath(SysconfigTemplate))
+	if err != nil {
+		return changes, err
+	}
+	schema, err := sysconfig.ParseSchema(bytes.NewReader(template))
+	if err != nil {
+		return changes, fmt.Errorf("%s: %s", RootPath(SysconfigTemplate), err.Error())
+	}
+
+	// the defaults of the previous migration, unknown the first time
+	var oldDefaults sysconfig.Config
+	oldSchema, err := sysconfig.ParseSchemaFile(RootPath(DefaultsSnapshot))
+	if err == nil {
+		oldDefaults = oldSchema.Defaults()
+	} else if !os.IsNotExist(err) {
+		return changes, err
+	}
+
+	path := RootPath(SysconfigFile)
+	src, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return changes, err
+	}
+	installed := make(sysconfig.Config)
+	installed.Unmarshal(bytes.NewReader(src))
+	force := installed[migrateFlag] == "yes"
+
+	cfg := make(sysconfig.Config)
+	for _, o := range schema {
+		value, ok := installed[o.Key]
+		switch {
+		case o.Key == migrateFlag:
+			if value != "no" {
+				cfg[o.Key] = "no"
+				changes = append(changes, fmt.Sprintf("%s: reset to \"no\"", o.Key))
+			}
+		case !ok:
+			cfg[o.Key] = o.Default
+			changes = append(changes, fmt.Sprintf("%s: added with default \"%s\"", o.Key, o.Default))
+		case value == o.Default:
+		case force:
+			cfg[o.Key] = o.Default
+			changes = append(changes, fmt.Sprintf("%s: \"%s\" -> \"%s\" (forced to the new default)", o.Key, value, o.Default))
+		case oldDefaults != nil && value == oldDefaults[o.Key]:
+			cfg[o.Key] = o.Default
+			changes = append(changes, fmt.Sprintf("%s: \"%s\" -> \"%s\" (the default changed)", o.Key, value, o.Default))
+		}
+	}
+
+	var obsolete []string
+	for k := range installed {
+		if _, ok := schema.Lookup(k); !ok {
+			obsolete = append(obsolete, k)
+		}
+	}
+	sort.Strings(obsolete)
+	for _, k := range obsolete {
+		changes = append(changes, fmt.Sprintf("%s: dropped, no longer used", k))
+	}
+
+	if len(changes) > 0 {
+		content := sysconfig.RemoveVariables(cfg.Marshal(src, template), obsolete...)
+		err = w.WriteFile(path, content)
+		if err != nil {
+			return changes, fmt.Errorf("can not write %s: %s", path, err.Error())
+		}
+	}
+
+	err = w.WriteFile(RootPath(DefaultsSnapshot), template)
+	if err != nil {
+		return changes, fmt.Errorf("can not write %s: %s", RootPath(DefaultsSnapshot), err.Error())
+	}
+	return changes, nil
+}
@@ -0,0 +1,99 @@
+package lib
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// migrateTemplate a sysconfig template with the migration flag and two settings
+const migrateTemplate = `## Type: yesno
+## Default: no
+FORCE_MODIFY_DEFAULT_FONT_SETTINGS_IN_NEXT_UPDATE="no"
+
+## Type: yesno
+## Default: yes
+FORCE_BW="yes"
+
+## Type: string
+PREFER_SANS_FAMILIES="Noto Sans"
+`
+
+// oldTemplate the previous template, with other defaults
+const oldTemplate = `## Type: yesno
+FORCE_BW="no"
+
+## Type: string
+PREFER_SANS_FAMILIES="DejaVu Sans"
+`
+
+func TestMigrate(t *testing.T) {
+	tests := []struct {
+		name      string
+		installed string
+		// snapshot the template of the previous migration, none if empty
+		snapshot string
+		changes  []string
+		// want the installed file after the migration, unchanged if empty
+		want string
+	}{
+		{"up to date", "FORCE_MODIFY_DEFAULT_FONT_SETTINGS_IN_NEXT_UPDATE=\"no\"\nFORCE_BW=\"yes\"\nPREFER_SANS_FAMILIES=\"Noto Sans\"\n",
+			oldTemplate, nil, ""},
+		{"old defaults",
+			"FORCE_MODIFY_DEFAULT_FONT_SETTINGS_IN_NEXT_UPDATE=\"no\"\n# black and white\nFORCE_BW=no\nPREFER_SANS_FAMILIES=\"Mine\"\n",
+			oldTemplate,
+			[]string{`FORCE_BW: "no" -> "yes" (the default changed)`},
+			"FORCE_MODIFY_DEFAULT_FONT_SETTINGS_IN_NEXT_UPDATE=\"no\"\n# black and white\nFORCE_BW=yes\nPREFER_SANS_FAMILIES=\"Mine\"\n"},
+		{"first migration keeps values",
+			"FORCE_BW=\"no\"\nPREFER_SANS_FAMILIES=\"DejaVu Sans\"\n",
+			"",
+			[]string{`FORCE_MODIFY_DEFAULT_FONT_SETTINGS_IN_NEXT_UPDATE: reset to "no"`},
+			"FORCE_BW=\"no\"\nPREFER_SANS_FAMILIES=\"DejaVu Sans\"\n\n## Type: yesno\n## Default: no\nFORCE_MODIFY_DEFAULT_FONT_SETTINGS_IN_NEXT_UPDATE=\"no\"\n"},
+		{"forced",
+			"FORCE_MODIFY_DEFAULT_FONT_SETTINGS_IN_NEXT_UPDATE=\"yes\"\nFORCE_BW=\"no\"\nPREFER_SANS_FAMILIES=\"Mine\"\nOBSOLETE=\"1\"\n",
+			oldTemplate,
+			[]string{
+				`FORCE_MODIFY_DEFAULT_FONT_SETTINGS_IN_NEXT_UPDATE: reset to "no"`,
+				`FORCE_BW: "no" -> "yes" (forced to the new default)`,
+				`PREFER_SANS_FAMILIES: "Mine" -> "Noto Sans" (forced to the new default)`,
+				`OBSOLETE: dropped, no longer used`,
+			},
+			"FORCE_MODIFY_DEFAULT_FONT_SETTINGS_IN_NEXT_UPDATE=\"no\"\nFORCE_BW=\"yes\"\nPREFER_SANS_FAMILIES=\"Noto Sans\"\n"},
+		{"new variable",
+			"FORCE_MODIFY_DEFAULT_FONT_SETTINGS_IN_NEXT_UPDATE=\"no\"\nFORCE_BW=\"yes\"\n",
+			oldTemplate,
+			[]string{`PREFER_SANS_FAMILIES: added with default "Noto Sans"`},
+			"FORCE_MODIFY_DEFAULT_FONT_SETTINGS_IN_NEXT_UPDATE=\"no\"\nFORCE_BW=\"yes\"\n\n## Type: string\nPREFER_SANS_FAMILIES=\"Noto Sans\"\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := map[string]string{
+				strings.TrimPrefix(SysconfigTemplate, "/"): migrateTemplate,
+				strings.TrimPrefix(SysconfigFile, "/"):     tt.installed,
+			}
+			if len(tt.snapshot) > 0 {
+				files[strings.TrimPrefix(DefaultsSnapshot, "/")] = tt.snapshot
+			}
+			setTestRoot(t, files)
+
+			w := NewMemoryWriter()
+			changes, err := Migrate(w)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(changes, tt.changes) {
+				t.Errorf("changes\n%s\nwant\n%s", strings.Join(changes, "\n"), strings.Join(tt.changes, "\n"))
+			}
+			got, written := w.Files[RootPath(SysconfigFile)]
+			switch {
+			case len(tt.want) == 0 && written:
+				t.Errorf("rewrote an up to date file:\n%s", got)
+			case len(tt.want) > 0 && string(got) != tt.want:
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+			if string(w.Files[RootPath(DefaultsSnapshot)]) != migrateTemplate {
+				t.Error("the template is not kept as the defaults of the next migration")
+			}
+		})
+	}
+}
@@ -1,25 +1,26 @@
 package lib
 
 import (
+	"sort"
 	"strings"
 
 	ft "github.com/marguerite/fonts-config-ng/font"
+	"github.com/marguerite/fonts-config-ng/fontconfig"
 	"github.com/marguerite/go-stdlib/slice"
 )
 
 // GenNotoConfig generate fontconfig for Noto Fonts
-func GenNotoConfig(c ft.Collection, userMode bool) {
+func GenNotoConfig(w Writer, c ft.Collection, userMode bool) error {
 	c = c.FindByName("Noto")
-	family := genNotoDefaultFamily(c, userMode)
-	fpl := genNotoConfig(c, userMode)
-	faPos := GetFcConfig("notoDefault", userMode)
-	fplPos := GetFcConfig("notoPrefer", userMode)
-	overwriteOrRemoveFile(faPos, []byte(family))
-	overwriteOrRemoveFile(fplPos, []byte(fpl))
+	err := writeFcDocument(w, GetFcConfig("notoDefault", userMode), genNotoDefaultFamily(c, userMode))
+	if err != nil {
+		return err
+	}
+	return writeFcDocument(w, GetFcConfig("notoPrefer", userMode), genNotoConfig(c, userMode))
 }
 
-func genNotoDefaultFamily(c ft.Collection, userMode bool) string {
-	str := genFcPreamble(userMode, "<!-- Default families for Noto Fonts installed on your system.-->")
+func genNotoDefaultFamily(c ft.Collection, userMode bool) *fontconfig.Document {
+	doc := newFcDocument(userMode, " Default families for Noto Fonts installed on your system. ")
 	// font names across different font.Name may be equal.
 	m := make(map[string]struct{})
 
@@ -27,23 +28,21 @@ func genNotoDefaultFamily(c ft.Collection, userMode bool) string {
 		for _, name := range font.Name {
 			if _, ok := m[name]; !ok {
 				m[name] = struct{}{}
-				str += genDefaultFamily(name)
+				doc.Append(genDefaultFamily(name))
 			}
 		}
 	}
 
-	str += FcSuffix
-
-	return str
+	return doc
 }
 
-func genNotoConfig(c ft.Collection, userMode bool) string {
+func genNotoConfig(c ft.Collection, userMode bool) *fontconfig.Document {
 	nonLangFonts := []string{"Noto Sans", "Noto Sans Display",
 		"Noto Sans Mono", "Noto Sans Symbols", "Noto Sans Symbols2",
 		"Noto Serif", "Noto Serif Display",
 		"Noto Mono", "Noto Emoji", "Noto Color Emoji"}
 
-	var str string
+	doc := newFcDocument(userMode, " Language specific family preference list for Noto Fonts installed on your system. ")
 
 	for _, v := range []string{"sans-serif", "serif", "monospace"} {
 		m := make(map[string][]string)
@@ -68,28 +67,27 @@ func genNotoConfig(c ft.Collection, userMode bool) string {
 			}
 		}
 
-		for k, v1 := range m {
-			str += "\t<match>\n\t\t<test name=\"family\">\n\t\t\t<string>" + v + "</string>\n\t\t</test>\n" +
-				"\t\t<test name=\"lang\">\n\t\t\t<string>" + k + "</string>\n\t\t</test>\n" +
-				"\t\t<edit name=\"family\" mode=\"prepend\">\n"
-			for _, v2 := range v1 {
-				str += "\t\t\t<string>" + v2 + "</string>\n"
-			}
-			str += "\t\t</edit>\n\t</match>\n\n"
+		// iterate langs in a stable order, so the generated file is stable between runs
+		langs := make([]string, 0, len(m))
+		for k := range m {
+			langs = append(langs, k)
+		}
+		sort.Strings(langs)
+
+		for _, k := range langs {
+			doc.Append(fontconfig.Match{
+				Tests: []fontconfig.Test{familyTest(v, ""), langTest(k, "")},
+				Edits: []fontconfig.Edit{familyEdit("prepend", "", m[k]...)},
+			})
 		}
 	}
 
-	return genFcPreamble(userMode, "<!-- Language specific family preference list for Noto Fonts installed on your system.-->") +
-		str +
-		FcSuffix
+	return doc
 }
 
 // genDefaultFamily generate default family fontconfig block for font name
-func genDefaultFamily(name string) string {
-	str := "\t<alias>\n\t\t<family>" + name + "</family>\n\t\t<default>\n\t\t\t<family>"
-	str += getGenericFamily(name)
-	str += "</family>\n\t\t</default>\n\t</alias>\n\n"
-	return str
+func genDefaultFamily(name string) fontconfig.Alias {
+	return fontconfig.Alias{Family: []string{name}, Default: fontconfig.NewFamilyList(getGenericFamily(name))}
 }
 
 // getGenericFamily get generic name through font name
@@ -2,74 +2,76 @@ package lib
 
 import (
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/marguerite/fonts-config-ng/fontconfig"
 	"github.com/marguerite/fonts-config-ng/sysconfig"
 )
 
-func genBitmapLanguagesConfig(s sysconfig.Config) string {
-	tmp := "\t<match target=\"font\">\n\t\t<edit name=\"embeddedbitmap\" mode=\"append\">\n"
-	if s.Bool("USE_EMBEDDED_BITMAPS") {
-		if len(s.String("EMBEDDED_BITMAPS_LANGUAGES")) == 0 {
-			tmp += "\t\t\t<b>true</bool>\n\t\t</edit>\n\t</match>\n"
-			return tmp
-		}
-		tmp += "\t\t\t<bool>false</bool>\n\t\t</edit>\n\t</match>\n"
-		for _, v := range strings.Split(s.String("EMBEDDED_BITMAPS_LANGUAGES"), ":") {
-			tmp += "\t<match target=\"font\">\n\t\t<test name=\"lang\" compare=\"contains\"><string>" + v +
-				"</string></test>\n\t\t<edit name=\"embeddedbitmap\" mode=\"append\"><bool>true</bool></edit>\n\t</match>\n"
+func genBitmapLanguagesConfig(s sysconfig.Settings) []fontconfig.Node {
+	embeddedBitmap := func(b bool) fontconfig.Edit {
+		return fontconfig.Edit{Name: "embeddedbitmap", Mode: "append", Values: []fontconfig.Expr{fontconfig.Bool(b)}}
+	}
+
+	if s.UseEmbeddedBitmaps && len(s.EmbeddedBitmapsLanguages) == 0 {
+		return []fontconfig.Node{fontconfig.Match{Target: "font", Edits: []fontconfig.Edit{embeddedBitmap(true)}}}
+	}
+
+	nodes := []fontconfig.Node{fontconfig.Match{Target: "font", Edits: []fontconfig.Edit{embeddedBitmap(false)}}}
+	if s.UseEmbeddedBitmaps {
+		for _, v := range strings.Split(s.EmbeddedBitmapsLanguages, ":") {
+			nodes = append(nodes, fontconfig.Match{
+				Target: "font",
+				Tests:  []fontconfig.Test{langTest(v, "contains")},
+				Edits:  []fontconfig.Edit{embeddedBitmap(true)},
+			})
 		}
-		return tmp
 	}
-	tmp += "\t\t\t<bool>false</bool>\n\t\t</edit>\n\t</match>\n"
-	return tmp
+	return nodes
 }
 
 // GenRenderingOptions generates fontconfig rendering options conf
-func GenRenderingOptions(userMode bool, s sysconfig.Config) {
+func GenRenderingOptions(w Writer, userMode bool, s sysconfig.Settings) error {
 	/* # reflect fonts-config syconfig variables or
 	   # parameters in fontconfig setting to control rendering */
 	renderFile := GetFcConfig("render", userMode)
 
-	Dbg(s.Int("VERBOSITY"), Debug, fmt.Sprintf("Generating %s.", renderFile))
-	renderText := genRenderingOptions(s, userMode)
+	Dbg(s.Verbosity, Debug, fmt.Sprintf("Generating %s.", renderFile))
 
-	err := overwriteOrRemoveFile(renderFile, []byte(renderText))
-	if err != nil {
-		log.Fatalf("Can not write %s: %s\n", renderFile, err.Error())
-	}
+	return writeFcDocument(w, renderFile, genRenderingOptions(s, userMode))
 }
 
-func genRenderingOptions(s sysconfig.Config, userMode bool) (cfg string) {
-	cfg += genStringOptionConfig(s.Int("VERBOSITY"), s.String("FORCE_HINTSTYLE"), "Forcing hintstyle:",
-		"<!-- Choose preferred common hinting style here.  -->\n<!-- Possible values: no, hitnone, hitslight, hintmedium and hintfull. -->\n<!-- Can be overridden with some other options, e. g. force_bw\n\tor force_bw_monospace => hintfull -->\n",
-		"force_hintstyle", false, true)
-	cfg += genBoolOptionConfig(s.Int("VERBOSITY"), s.Bool("FORCE_AUTOHINT"), "Forcing autohint:",
-		"<!-- Force autohint always. -->\n<!-- If false, for well hinted fonts, their instructions are used for rendering. -->\n",
-		"force_autohint", true)
-	cfg += genBoolOptionConfig(s.Int("VERBOSITY"), s.Bool("FORCE_BW"), "Forcing black and white:",
-		"<!-- Do not use font smoothing (black&white rendering) at all.  -->\n",
-		"force_bw", true)
-	cfg += genBoolOptionConfig(s.Int("VERBOSITY"), s.Bool("FORCE_BW_MONOSPACE"), "Forcing black and white for good hinted monospace:",
-		"<!-- Do not use font smoothing for some monospaced fonts.  -->\n<!-- Liberation Mono, Courier New, Andale Mono, Monaco, etc. -->\n",
-		"force_bw_monospace", true)
-	cfg += genStringOptionConfig(s.Int("VERBOSITY"), s.String("USE_LCDFILTER"), "Lcdfilter:",
-		"<!-- Set LCD filter. Amend when you want use subpixel rendering. -->\n<!-- Don't forgot to set correct subpixel ordering in 'rgba' element. -->\n<!-- Possible values: lcddefault, lcdlight, lcdlegacy, lcdnone -->\n",
-		"lcdfilter", true, false)
-	cfg += genStringOptionConfig(s.Int("VERBOSITY"), s.String("USE_RGBA"), "Subpixel arrangement:",
-		"<!-- Set LCD subpixel arrangement and orientation.  -->\n<!-- Possible values: unknown, none, rgb, bgr, vrgb, vbgr. -->\n",
-		"rgba", true, false)
-	cfg += genBitmapLanguagesConfig(s)
-	cfg += genBoolOptionConfig(s.Int("VERBOSITY"), s.Bool("SEARCH_METRIC_COMPATIBLE"), "Search metric compatible fonts:",
-		"<!-- Search for metric compatible families? -->\n",
-		"search_metric_aliases", false)
-	cfg += genUserInclude(userMode)
-	if len(cfg) == 0 {
-		return cfg
+func genRenderingOptions(s sysconfig.Settings, userMode bool) *fontconfig.Document {
+	var nodes []fontconfig.Node
+	nodes = append(nodes, genStringOptionConfig(s.Verbosity, s.ForceHintstyle, "Forcing hintstyle:",
+		[]string{" Choose preferred common hinting style here. ", " Possible values: no, hitnone, hitslight, hintmedium and hintfull. ", " Can be overridden with some other options, e. g. force_bw\n\tor force_bw_monospace => hintfull "},
+		"force_hintstyle", false, true)...)
+	nodes = append(nodes, genBoolOptionConfig(s.Verbosity, s.ForceAutohint, "Forcing autohint:",
+		[]string{" Force autohint always. ", " If false, for well hinted fonts, their instructions are used for rendering. "},
+		"force_autohint", true)...)
+	nodes = append(nodes, genBoolOptionConfig(s.Verbosity, s.ForceBW, "Forcing black and white:",
+		[]string{" Do not use font smoothing (black&white rendering) at all. "},
+		"force_bw", true)...)
+	nodes = append(nodes, genBoolOptionConfig(s.Verbosity, s.ForceBWMonospace, "Forcing black and white for good hinted monospace:",
+		[]string{" Do not use font smoothing for some monospaced fonts. ", " Liberation Mono, Courier New, Andale Mono, Monaco, etc. "},
+		"force_bw_monospace", true)...)
+	nodes = append(nodes, genStringOptionConfig(s.Verbosity, s.UseLcdfilter, "Lcdfilter:",
+		[]string{" Set LCD filter. Amend when you want use subpixel rendering. ", " Don't forgot to set correct subpixel ordering in 'rgba' element. ", " Possible values: lcddefault, lcdlight, lcdlegacy, lcdnone "},
+		"lcdfilter", true, false)...)
+	nodes = append(nodes, genStringOptionConfig(s.Verbosity, s.UseRGBA, "Subpixel arrangement:",
+		[]string{" Set LCD subpixel arrangement and orientation. ", " Possible values: unknown, none, rgb, bgr, vrgb, vbgr. "},
+		"rgba", true, false)...)
+	nodes = append(nodes, genBitmapLanguagesConfig(s)...)
+	nodes = append(nodes, genBoolOptionConfig(s.Verbosity, s.SearchMetricCompatible, "Search metric compatible fonts:",
+		[]string{" Search for metric compatible families? "},
+		"search_metric_aliases", false)...)
+	nodes = append(nodes, genUserInclude(userMode)...)
+	if len(nodes) == 0 {
+		return nil
 	}
-	return genFcPreamble(userMode, "<!-- using target=\"pattern\", because we want to change pattern in 60-family-prefer.conf\n\tregarding to this setting -->\n") +
-		cfg + FcSuffix
+	doc := newFcDocument(userMode, " using target=\"pattern\", because we want to change pattern in 60-family-prefer.conf\n\tregarding to this setting ")
+	doc.Append(nodes...)
+	return doc
 }
 
 // validStringOption return false if a string is "null", has suffix "none" or just empty.
@@ -80,59 +82,45 @@ func validStringOption(opt string) bool {
 	return true
 }
 
-func genStringOptionConfig(verbosity int, opt, dbgOutput, comment, editName string, cst, force bool) string {
-	if !validStringOption(opt) {
-		return ""
+// optionConfig comments followed by a match editing editName of pattern with value
+func optionConfig(comments []string, editName string, force bool, value fontconfig.Expr) []fontconfig.Node {
+	var nodes []fontconfig.Node
+	for _, c := range comments {
+		nodes = append(nodes, fontconfig.Comment(c))
 	}
-	Dbg(verbosity, Debug, fmt.Sprintf(dbgOutput+" %s", opt))
-	cfg := comment
-	cfg += "\t<match target=\"pattern\" >\n\t\t<edit name=\""
-	cfg += editName
-	cfg += "\" mode=\""
+	mode := "append"
 	if force {
-		cfg += "assign"
-	} else {
-		cfg += "append"
+		mode = "assign"
 	}
-	cfg += "\">\n\t\t\t"
-	if cst {
-		cfg += "<const>"
-	} else {
-		cfg += "<string>"
+	return append(nodes, fontconfig.Match{
+		Target: "pattern",
+		Edits:  []fontconfig.Edit{{Name: editName, Mode: mode, Values: []fontconfig.Expr{value}}},
+	})
+}
+
+func genStringOptionConfig(verbosity int, opt, dbgOutput string, comments []string, editName string, cst, force bool) []fontconfig.Node {
+	if !validStringOption(opt) {
+		return nil
 	}
-	cfg += opt
+	Dbg(verbosity, Debug, fmt.Sprintf(dbgOutput+" %s", opt))
+	var value fontconfig.Expr = fontconfig.String(opt)
 	if cst {
-		cfg += "</const>"
-	} else {
-		cfg += "</string>"
+		value = fontconfig.Const(opt)
 	}
-	cfg += "\n\t\t</edit>\n\t</match>\n"
-	return cfg
+	return optionConfig(comments, editName, force, value)
 }
 
-func genBoolOptionConfig(verbosity int, opt bool, dbgOutput, comment, editName string, force bool) string {
+func genBoolOptionConfig(verbosity int, opt bool, dbgOutput string, comments []string, editName string, force bool) []fontconfig.Node {
 	if strings.HasPrefix(editName, "force") && !opt {
-		return ""
+		return nil
 	}
 	Dbg(verbosity, Debug, fmt.Sprintf(dbgOutput+" %t", opt))
-	cfg := comment
-	cfg += "\t<match target=\"pattern\">\n\t\t<edit name=\""
-	cfg += editName
-	cfg += "\" mode=\""
-	if force {
-		cfg += "assign"
-	} else {
-		cfg += "append"
-	}
-	cfg += "\">\n\t\t\t<bool>"
-	cfg += fmt.Sprintf("%t", opt)
-	cfg += "</bool>\n\t\t</edit>\n\t</match>\n"
-	return cfg
+	return optionConfig(comments, editName, force, fontconfig.Bool(opt))
 }
 
-func genUserInclude(userMode bool) string {
+func genUserInclude(userMode bool) []fontconfig.Node {
 	if userMode {
-		return "\t<include ignore_missing=\"yes\" prefix=\"xdg\">fontconfig/rendering-options.conf</include>\n"
+		return []fontconfig.Node{fontconfig.Include{IgnoreMissing: "yes", Prefix: "xdg", Path: "fontconfig/rendering-options.conf"}}
 	}
-	return ""
+	return nil
 }
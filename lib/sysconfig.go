@@ -0,0 +1,45 @@
+package lib
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/marguerite/fonts-config-ng/sysconfig"
+)
+
+const (
+	// SysconfigFile the system wide settings of fonts-config
+	SysconfigFile string = "/etc/sysconfig/fonts-config"
+	// SysconfigTemplate the fillup template declaring the type and default of every setting
+	SysconfigTemplate string = "/usr/share/fillup-templates/sysconfig.fonts-config"
+)
+
+// WriteSysconfig update the settings file at path with the values of cfg through w.
+// comments and variables not in cfg are kept, new variables get their documentation from the template.
+func WriteSysconfig(w Writer, path string, cfg sysconfig.Config) error {
+	template, err := ioutil.ReadFile(RootPath(SysconfigTemplate))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	src, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	err = w.WriteFile(path, cfg.Marshal(src, template))
+	if err != nil {
+		return fmt.Errorf("can not write %s: %s", path, err.Error())
+	}
+	return nil
+}
+
+// SettingsFile the settings file of the system or of the current user
+func SettingsFile(userMode bool) string {
+	if userMode {
+		return RootPath(filepath.Join(configHome(), "fonts-config/settings"))
+	}
+	return RootPath(SysconfigFile)
+}
@@ -14,13 +14,17 @@ func FcCache(verbosity int) {
 	if cmd, err := exec.Search("/usr/bin/fc-cache"); err == nil {
 		Dbg(verbosity, Verbose, "Creating fontconfig cache files.\n")
 
-		opts := ""
+		opts := []string{}
 
 		if verbosity >= Verbose {
-			opts = "--verbose"
+			opts = append(opts, "--verbose")
 		}
 
-		_, status, _ := exec.Exec3(cmd, opts)
+		if root != "/" {
+			opts = append(opts, "--sysroot", root)
+		}
+
+		_, status, _ := exec.Exec3(cmd, opts...)
 
 		Dbg(verbosity, Debug, fmt.Sprintf("Exit status of fc-cache: %d\n", status))
 	}
@@ -28,6 +32,10 @@ func FcCache(verbosity int) {
 
 // FpRehash run xset fp rehash on the running system
 func FpRehash(verbosity int) {
+	if root != "/" {
+		Dbg(verbosity, Debug, "NOTE: do not run 'xset fp rehash', using an alternate root.\n")
+		return
+	}
 	if cmd, err := exec.Search("/usr/bin/xset"); err == nil {
 		re := regexp.MustCompile(`^:\d.*$`)
 		disp := os.Getenv("DISPLAY")
@@ -46,6 +54,10 @@ func FpRehash(verbosity int) {
 
 // ReloadXorgFontServer reload Xorg Font Server on the running system
 func ReloadXorgFontServer(verbosity int) {
+	if root != "/" {
+		Dbg(verbosity, Debug, "X Font Server not reloaded, using an alternate root.\n")
+		return
+	}
 	if cmd, err := exec.Search("/usr/bin/ps"); err == nil {
 		out, _, _ := exec.Exec3(cmd, "-C", "xfs", "-o", "pid=")
 		pid := strings.TrimSpace(string(out))
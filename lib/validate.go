@@ -0,0 +1,29 @@
+package lib
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/marguerite/fonts-config-ng/fontconfig"
+)
+
+// ValidateInstalled check every configuration file fontconfig loads against fonts.dtd and report the
+// violations to out. returns whether any file is invalid.
+func ValidateInstalled(userMode bool, out io.Writer) (bool, error) {
+	l := loadConfFiles(userMode)
+	// the files fontconfig fails to load, dangling symlinks are skipped by it and left to lint
+	invalid := len(l.broken) > 0
+	for _, p := range l.broken {
+		fmt.Fprintf(out, "%s\n", p.Message)
+	}
+	for _, f := range l.files {
+		err := fontconfig.Validate(f.doc)
+		if errs, ok := err.(fontconfig.ValidationErrors); ok {
+			invalid = true
+			for _, e := range errs {
+				fmt.Fprintf(out, "%s: %s\n", f.path, e.Error())
+			}
+		}
+	}
+	return invalid, nil
+}
@@ -0,0 +1,190 @@
+package lib
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Writer the destination of every file generated by fonts-config.
+// An empty content means the file should not exist at all.
+type Writer interface {
+	WriteFile(path string, content []byte) error
+}
+
+// FileWriter write generated files to disk
+type FileWriter struct{}
+
+// WriteFile overwrite path with content or completely remove it if content is empty
+func (FileWriter) WriteFile(path string, content []byte) error {
+	return overwriteOrRemoveFile(path, content)
+}
+
+// snapshot the content and metadata of a file before a transaction touched it
+type snapshot struct {
+	path    string
+	content []byte
+	meta    fileMeta
+}
+
+// restore put the file back to the snapshotted state
+func (s snapshot) restore() error {
+	if !s.meta.exists {
+		return overwriteOrRemoveFile(s.path, nil)
+	}
+	if len(s.meta.link) > 0 {
+		tmp := s.path + ".fonts-config-link"
+		os.Remove(tmp)
+		err := os.Symlink(s.meta.link, tmp)
+		if err != nil {
+			return err
+		}
+		return os.Rename(tmp, s.path)
+	}
+	return writeFileAtomic(s.path, s.content, s.meta)
+}
+
+// TransactionWriter atomically write generated files to disk like FileWriter, but keep the previous
+// content of every file it touched, so the whole set can be rolled back if any generator fails.
+type TransactionWriter struct {
+	snapshots []snapshot
+	// dirs the directories created for new files, outermost first
+	dirs []string
+}
+
+// missingDirs the directories to create for path, outermost first
+func missingDirs(path string) []string {
+	var dirs []string
+	for dir := filepath.Dir(path); ; dir = filepath.Dir(dir) {
+		if _, err := os.Lstat(dir); err == nil || dir == filepath.Dir(dir) {
+			break
+		}
+		dirs = append([]string{dir}, dirs...)
+	}
+	return dirs
+}
+
+// WriteFile snapshot path when it is touched for the first time and overwrite or remove it
+func (t *TransactionWriter) WriteFile(path string, content []byte) error {
+	touched := false
+	for _, s := range t.snapshots {
+		if s.path == path {
+			touched = true
+			break
+		}
+	}
+
+	if !touched {
+		meta, err := statFile(path)
+		if err != nil {
+			return err
+		}
+		var old []byte
+		if meta.exists && len(meta.link) == 0 {
+			old, err = ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+		}
+		t.snapshots = append(t.snapshots, snapshot{path, old, meta})
+	}
+	if len(content) > 0 {
+		t.dirs = append(t.dirs, missingDirs(path)...)
+	}
+
+	return overwriteOrRemoveFile(path, content)
+}
+
+// Rollback restore every touched file to its state before the transaction
+func (t *TransactionWriter) Rollback() error {
+	var failed []string
+	for i := len(t.snapshots) - 1; i >= 0; i-- {
+		err := t.snapshots[i].restore()
+		if err != nil {
+			failed = append(failed, err.Error())
+		}
+	}
+	// innermost first, a directory something else was written to in the meantime is kept
+	for i := len(t.dirs) - 1; i >= 0; i-- {
+		os.Remove(t.dirs[i])
+	}
+	t.snapshots, t.dirs = nil, nil
+	if len(failed) > 0 {
+		return fmt.Errorf("rollback failed: %s", strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+// Commit forget the snapshots, the written files become the new consistent state
+func (t *TransactionWriter) Commit() {
+	t.snapshots, t.dirs = nil, nil
+}
+
+// DryRunWriter print the would-be path and content of generated files instead of writing them
+type DryRunWriter struct {
+	Out io.Writer
+}
+
+// WriteFile print path and content to Out
+func (w DryRunWriter) WriteFile(path string, content []byte) error {
+	if len(content) == 0 {
+		_, err := fmt.Fprintf(w.Out, "### %s (would be removed)\n\n", path)
+		return err
+	}
+	_, err := fmt.Fprintf(w.Out, "### %s\n%s\n", path, content)
+	return err
+}
+
+// MemoryWriter keep generated files in memory in the order they were written
+type MemoryWriter struct {
+	Paths []string
+	Files map[string][]byte
+	// Previous the content of the files on disk before their first write, filled by RecordingWriter
+	Previous map[string][]byte
+}
+
+// NewMemoryWriter initialize an empty MemoryWriter
+func NewMemoryWriter() *MemoryWriter {
+	return &MemoryWriter{Files: make(map[string][]byte), Previous: make(map[string][]byte)}
+}
+
+// WriteFile record content for path. the last write to a path wins.
+func (w *MemoryWriter) WriteFile(path string, content []byte) error {
+	if _, ok := w.Files[path]; !ok {
+		w.Paths = append(w.Paths, path)
+	}
+	w.Files[path] = append([]byte{}, content...)
+	return nil
+}
+
+// RecordingWriter pass every file to Writer and remember what was successfully written in Record,
+// along with the content it had before
+type RecordingWriter struct {
+	Writer
+	Record *MemoryWriter
+}
+
+// WriteFile write path through Writer and record it
+func (w RecordingWriter) WriteFile(path string, content []byte) error {
+	_, seen := w.Record.Files[path]
+	var old []byte
+	if !seen {
+		var err error
+		old, err = ioutil.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("can not read %s: %s", path, err.Error())
+		}
+	}
+
+	err := w.Writer.WriteFile(path, content)
+	if err != nil {
+		return err
+	}
+	if !seen {
+		w.Record.Previous[path] = old
+	}
+	return w.Record.WriteFile(path, content)
+}
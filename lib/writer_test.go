@@ -0,0 +1,172 @@
+package lib
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDryRunWriter(t *testing.T) {
+	dir := t.TempDir()
+	created := filepath.Join(dir, "conf.d/10-new.conf")
+	removed := filepath.Join(dir, "conf.d/20-old.conf")
+	if err := os.MkdirAll(filepath.Dir(removed), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(removed, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	w := DryRunWriter{&out}
+	if err := w.WriteFile(created, []byte("new")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteFile(removed, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "### " + created + "\nnew\n### " + removed + " (would be removed)\n\n"
+	if out.String() != want {
+		t.Errorf("printed\n%s\nwant\n%s", out.String(), want)
+	}
+	if _, err := os.Stat(created); !os.IsNotExist(err) {
+		t.Errorf("%s was written", created)
+	}
+	if b, err := os.ReadFile(removed); err != nil || string(b) != "old" {
+		t.Errorf("%s was touched: %q, %v", removed, b, err)
+	}
+}
+
+func TestTransactionWriterRollback(t *testing.T) {
+	dir := t.TempDir()
+	changed := filepath.Join(dir, "changed.conf")
+	removed := filepath.Join(dir, "removed.conf")
+	created := filepath.Join(dir, "new/conf.d/created.conf")
+	if err := os.WriteFile(changed, []byte("old"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(removed, []byte("removed"), 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	tx := &TransactionWriter{}
+	for path, content := range map[string]string{changed: "new", removed: "", created: "created"} {
+		if err := tx.WriteFile(path, []byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// only the first write of a file is snapshotted
+	if err := tx.WriteFile(changed, []byte("newer")); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := os.ReadFile(created); string(b) != "created" {
+		t.Fatalf("%s not written", created)
+	}
+
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	for path, want := range map[string]struct {
+		content string
+		mode    os.FileMode
+	}{changed: {"old", 0600}, removed: {"removed", 0640}} {
+		b, err := os.ReadFile(path)
+		if err != nil || string(b) != want.content {
+			t.Errorf("%s restored to %q, %v, want %q", path, b, err, want.content)
+			continue
+		}
+		if info, _ := os.Stat(path); info.Mode().Perm() != want.mode {
+			t.Errorf("%s restored with mode %o, want %o", path, info.Mode().Perm(), want.mode)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "new")); !os.IsNotExist(err) {
+		t.Errorf("the directories created for %s are left behind", created)
+	}
+}
+
+func TestTransactionWriterCommit(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "conf.d/10-rendering-options.conf")
+	tx := &TransactionWriter{}
+	if err := tx.WriteFile(path, []byte("new")); err != nil {
+		t.Fatal(err)
+	}
+	tx.Commit()
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	if b, err := os.ReadFile(path); err != nil || string(b) != "new" {
+		t.Errorf("committed file rolled back: %q, %v", b, err)
+	}
+}
+
+func TestOverwriteKeepsMetadata(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "10-rendering-options.conf")
+	if err := os.WriteFile(path, []byte("old"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	before, err := statFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	label := []byte("system_u:object_r:fonts_t:s0\x00")
+	labeled := setSecurityLabel(path, label) == nil
+	if labeled {
+		before.label = getSecurityLabel(path)
+	}
+
+	if err := overwriteOrRemoveFile(path, []byte("new")); err != nil {
+		t.Fatal(err)
+	}
+	after, err := statFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.mode != 0600 || after.uid != before.uid || after.gid != before.gid {
+		t.Errorf("mode %o owner %d:%d, want %o %d:%d", after.mode, after.uid, after.gid, before.mode, before.uid, before.gid)
+	}
+	if !labeled {
+		t.Log("SELinux labels can not be set here, not checked")
+	} else if !bytes.Equal(after.label, before.label) {
+		t.Errorf("label %q, want %q", after.label, before.label)
+	}
+}
+
+func TestOverwriteSymlink(t *testing.T) {
+	dir := t.TempDir()
+	shipped := filepath.Join(dir, "conf.avail/10-rendering-options.conf")
+	path := filepath.Join(dir, "conf.d/10-rendering-options.conf")
+	for _, d := range []string{filepath.Dir(shipped), filepath.Dir(path)} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(shipped, []byte("shipped"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("../conf.avail/10-rendering-options.conf", path); err != nil {
+		t.Fatal(err)
+	}
+
+	tx := &TransactionWriter{}
+	if err := tx.WriteFile(path, []byte("generated")); err != nil {
+		t.Fatal(err)
+	}
+	// the symlink is replaced, the file it pointed to is left alone
+	if info, err := os.Lstat(path); err != nil || !info.Mode().IsRegular() {
+		t.Errorf("%s is not a regular file: %v", path, err)
+	}
+	if b, _ := os.ReadFile(shipped); string(b) != "shipped" {
+		t.Errorf("the symlink target was written: %q", b)
+	}
+
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	if target, err := os.Readlink(path); err != nil || target != "../conf.avail/10-rendering-options.conf" {
+		t.Errorf("symlink not restored: %q, %v", target, err)
+	}
+}
@@ -0,0 +1,21 @@
+package sysconfig
+
+// Layer a source of settings, eg. the template defaults, a sysconfig file, the environment or cli flags
+type Layer struct {
+	Origin string
+	Config Config
+}
+
+// Merge apply layers in order, later layers overriding earlier ones.
+// returns the effective configuration and the origin of every value.
+func Merge(layers ...Layer) (Config, map[string]string) {
+	cfg := make(Config)
+	origins := make(map[string]string)
+	for _, l := range layers {
+		for k, v := range l.Config {
+			cfg[k] = v
+			origins[k] = l.Origin
+		}
+	}
+	return cfg, origins
+}
@@ -0,0 +1,29 @@
+package sysconfig
+
+import "testing"
+
+func TestMerge(t *testing.T) {
+	cfg, origins := Merge(
+		Layer{"default", Config{"FORCE_BW": "no", "THRESHOLD": "0", "PREFER_SANS_FAMILIES": ""}},
+		Layer{"/etc/sysconfig/fonts-config", Config{"FORCE_BW": "yes", "THRESHOLD": "4"}},
+		Layer{"~/.config/fonts-config/settings", Config{"THRESHOLD": "6"}},
+		Layer{"environment", Config{}},
+		Layer{"flag", Config{"THRESHOLD": "8", "PREFER_SANS_FAMILIES": ""}},
+	)
+	tests := []struct {
+		key, value, origin string
+	}{
+		{"FORCE_BW", "yes", "/etc/sysconfig/fonts-config"},
+		{"THRESHOLD", "8", "flag"},
+		// an empty value in a later layer still overrides
+		{"PREFER_SANS_FAMILIES", "", "flag"},
+	}
+	for _, tt := range tests {
+		if cfg[tt.key] != tt.value || origins[tt.key] != tt.origin {
+			t.Errorf("%s = %q from %s, want %q from %s", tt.key, cfg[tt.key], origins[tt.key], tt.value, tt.origin)
+		}
+	}
+	if len(cfg) != 3 || len(origins) != 3 {
+		t.Errorf("merged %v", cfg)
+	}
+}
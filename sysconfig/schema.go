@@ -0,0 +1,220 @@
+package sysconfig
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Kind the type of a sysconfig variable as declared by "## Type:"
+type Kind int
+
+const (
+	// String any text
+	String Kind = iota
+	// Integer a decimal number
+	Integer
+	// YesNo "yes" or "no"
+	YesNo
+	// List one of the listed values
+	List
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Integer:
+		return "integer"
+	case YesNo:
+		return "yesno"
+	case List:
+		return "list"
+	}
+	return "string"
+}
+
+// Option the metadata of a sysconfig variable
+type Option struct {
+	Key     string
+	Kind    Kind
+	Values  []string
+	Default string
+	// Documented the "## Default:" of the template when it differs from Default, the value the
+	// template assigns and every installation starts with
+	Documented  string
+	Description string
+}
+
+// Validate check value against the type of o
+func (o Option) Validate(value string) error {
+	switch o.Kind {
+	case Integer:
+		if _, err := strconv.Atoi(value); err != nil {
+			return fmt.Errorf("invalid value %q for %s: must be an integer", value, o.Key)
+		}
+	case YesNo:
+		if value != "yes" && value != "no" {
+			return fmt.Errorf("invalid value %q for %s: must be yes or no", value, o.Key)
+		}
+	case List:
+		for _, v := range o.Values {
+			if v == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid value %q for %s: must be one of %s", value, o.Key, strings.Join(o.Values, ", "))
+	}
+	return nil
+}
+
+// Schema the variables of a sysconfig template in the order they are declared
+type Schema []Option
+
+// ParseSchema parse the "## Type:" and "## Default:" metadata of a fillup template.
+// the value a template assigns is the default, a "## Default:" saying otherwise is kept in Documented.
+func ParseSchema(f io.Reader) (Schema, error) {
+	var schema Schema
+	var opt Option
+	hasDefault := false
+	s := bufio.NewScanner(f)
+	n := 0
+
+	for s.Scan() {
+		n++
+		line := strings.TrimSpace(s.Text())
+		if strings.HasPrefix(line, "##") {
+			arr := strings.SplitN(strings.TrimPrefix(line, "##"), ":", 2)
+			if len(arr) < 2 {
+				continue
+			}
+			value := strings.TrimSpace(arr[1])
+			switch strings.TrimSpace(arr[0]) {
+			case "Type":
+				kind, values, err := parseType(value)
+				if err != nil {
+					return schema, fmt.Errorf("line %d: %s", n, err.Error())
+				}
+				opt.Kind, opt.Values = kind, values
+			case "Default":
+				opt.Default = unquote(value)
+				hasDefault = true
+			case "Description":
+				opt.Description = value
+			}
+			continue
+		}
+		if strings.HasPrefix(line, "#") || len(line) == 0 {
+			continue
+		}
+
+		key, value, ok := parseLine(line)
+		if !ok {
+			return schema, fmt.Errorf("line %d: not a variable assignment: %s", n, line)
+		}
+		opt.Key = key
+		if hasDefault && opt.Default != value {
+			opt.Documented = opt.Default
+		}
+		opt.Default = value
+		if err := opt.Validate(opt.Default); err != nil {
+			return schema, fmt.Errorf("line %d: default: %s", n, err.Error())
+		}
+		schema = append(schema, opt)
+		opt = Option{}
+		hasDefault = false
+	}
+
+	return schema, s.Err()
+}
+
+// ParseSchemaFile parse the fillup template at path
+func ParseSchemaFile(path string) (Schema, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	schema, err := ParseSchema(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err.Error())
+	}
+	return schema, nil
+}
+
+// parseType parse the value of "## Type:"
+func parseType(s string) (Kind, []string, error) {
+	switch {
+	case s == "string":
+		return String, nil, nil
+	case s == "integer":
+		return Integer, nil, nil
+	case s == "yesno":
+		return YesNo, nil, nil
+	case strings.HasPrefix(s, "list(") && strings.HasSuffix(s, ")"):
+		var values []string
+		for _, v := range strings.Split(strings.TrimSuffix(strings.TrimPrefix(s, "list("), ")"), ",") {
+			values = append(values, strings.TrimSpace(v))
+		}
+		return List, values, nil
+	}
+	return String, nil, fmt.Errorf("unsupported type %q", s)
+}
+
+// Mismatches describe the options whose documented default differs from the value the template assigns
+func (schema Schema) Mismatches() []string {
+	var mismatches []string
+	for _, o := range schema {
+		if len(o.Documented) > 0 {
+			mismatches = append(mismatches, fmt.Sprintf("%s: documented default %q differs from the value %q", o.Key, o.Documented, o.Default))
+		}
+	}
+	return mismatches
+}
+
+// Lookup find the option of key
+func (schema Schema) Lookup(key string) (Option, bool) {
+	for _, o := range schema {
+		if o.Key == key {
+			return o, true
+		}
+	}
+	return Option{}, false
+}
+
+// Defaults the configuration with every variable at its default value
+func (schema Schema) Defaults() Config {
+	cfg := make(Config)
+	for _, o := range schema {
+		cfg[o.Key] = o.Default
+	}
+	return cfg
+}
+
+// Validate check every known value of cfg, returns all the problems found at once
+func (schema Schema) Validate(cfg Config) error {
+	keys := make([]string, 0, len(cfg))
+	for k := range cfg {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var errs []string
+	for _, k := range keys {
+		o, ok := schema.Lookup(k)
+		if !ok {
+			// obsolete variables are left alone until migrated
+			continue
+		}
+		if err := o.Validate(cfg[k]); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package sysconfig
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseSchemaTemplate(t *testing.T) {
+	schema, err := ParseSchemaFile("../data/sysconfig.fonts-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	o, ok := schema.Lookup("FORCE_FAMILY_PREFERENCE_LISTS")
+	if !ok || o.Kind != YesNo || o.Default != "no" || o.Documented != "yes" {
+		t.Errorf("FORCE_FAMILY_PREFERENCE_LISTS parsed as %#v", o)
+	}
+}
+
+func TestParseSchema(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want Option
+		err  string
+	}{
+		{"default", "## Type: yesno\n## Default: yes\nA=\"yes\"\n", Option{Key: "A", Kind: YesNo, Default: "yes"}, ""},
+		{"no default", "## Type: integer\nA=\"3\"\n", Option{Key: "A", Kind: Integer, Default: "3"}, ""},
+		{"list", "## Type: list(a,b)\n## Default: \"b\"\n## Description: pick one\nA=\"b\"\n",
+			Option{Key: "A", Kind: List, Values: []string{"a", "b"}, Default: "b", Description: "pick one"}, ""},
+		{"default differs", "## Type: yesno\n## Default: yes\nA=\"no\"\n", Option{Key: "A", Kind: YesNo, Default: "no", Documented: "yes"}, ""},
+		{"invalid default", "## Type: yesno\nA=\"maybe\"\n", Option{}, "must be yes or no"},
+		{"unsupported type", "## Type: float\nA=\"1.0\"\n", Option{}, `unsupported type "float"`},
+		{"not an assignment", "## Type: string\nA\n", Option{}, "not a variable assignment"},
+	}
+	for _, tt := range tests {
+		schema, err := ParseSchema(strings.NewReader(tt.in))
+		if len(tt.err) > 0 {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("%s: got %v, want %q", tt.name, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %s", tt.name, err)
+			continue
+		}
+		if len(schema) != 1 || !reflect.DeepEqual(schema[0], tt.want) {
+			t.Errorf("%s: got %#v, want %#v", tt.name, schema, tt.want)
+		}
+	}
+}
+
+func TestMismatches(t *testing.T) {
+	schema, err := ParseSchema(strings.NewReader("## Type: yesno\n## Default: yes\nA=\"no\"\n## Type: yesno\n## Default: yes\nB=\"yes\"\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{`A: documented default "yes" differs from the value "no"`}
+	if got := schema.Mismatches(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
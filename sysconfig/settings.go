@@ -0,0 +1,70 @@
+package sysconfig
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Settings the typed values of /etc/sysconfig/fonts-config
+type Settings struct {
+	Verbosity                                  int    `sysconfig:"VERBOSITY"`
+	ForceHintstyle                             string `sysconfig:"FORCE_HINTSTYLE"`
+	ForceAutohint                              bool   `sysconfig:"FORCE_AUTOHINT"`
+	ForceBW                                    bool   `sysconfig:"FORCE_BW"`
+	ForceBWMonospace                           bool   `sysconfig:"FORCE_BW_MONOSPACE"`
+	UseLcdfilter                               string `sysconfig:"USE_LCDFILTER"`
+	UseRGBA                                    string `sysconfig:"USE_RGBA"`
+	UseEmbeddedBitmaps                         bool   `sysconfig:"USE_EMBEDDED_BITMAPS"`
+	EmbeddedBitmapsLanguages                   string `sysconfig:"EMBEDDED_BITMAPS_LANGUAGES"`
+	PreferSansFamilies                         string `sysconfig:"PREFER_SANS_FAMILIES"`
+	PreferSerifFamilies                        string `sysconfig:"PREFER_SERIF_FAMILIES"`
+	PreferMonoFamilies                         string `sysconfig:"PREFER_MONO_FAMILIES"`
+	SearchMetricCompatible                     bool   `sysconfig:"SEARCH_METRIC_COMPATIBLE"`
+	ForceFamilyPreferenceLists                 bool   `sysconfig:"FORCE_FAMILY_PREFERENCE_LISTS"`
+	EmojiBlacklist                             bool   `sysconfig:"EMOJI_BLACKLIST"`
+	PreferEmojiFamily                          string `sysconfig:"PREFER_EMOJI_FAMILY"`
+	EmojiBlacklistAllowlist                    string `sysconfig:"EMOJI_BLACKLIST_ALLOWLIST"`
+	EmojiBlacklistKeepRanges                   string `sysconfig:"EMOJI_BLACKLIST_KEEP_RANGES"`
+	EmojiPresentation                          string `sysconfig:"EMOJI_PRESENTATION"`
+	EmojiCharset                               string `sysconfig:"EMOJI_CHARSET"`
+	GenerateTTCapEntries                       bool   `sysconfig:"GENERATE_TTCAP_ENTRIES"`
+	GenerateJavaFontSetup                      bool   `sysconfig:"GENERATE_JAVA_FONT_SETUP"`
+	ForceModifyDefaultFontSettingsInNextUpdate bool   `sysconfig:"FORCE_MODIFY_DEFAULT_FONT_SETTINGS_IN_NEXT_UPDATE"`
+}
+
+// Settings validate cfg against the schema and decode it, variables missing from cfg take their default value
+func (schema Schema) Settings(cfg Config) (Settings, error) {
+	var s Settings
+
+	values := schema.Defaults()
+	for k, v := range cfg {
+		values[k] = v
+	}
+	if err := schema.Validate(values); err != nil {
+		return s, err
+	}
+
+	v := reflect.ValueOf(&s).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		key := v.Type().Field(i).Tag.Get("sysconfig")
+		value, ok := values[key]
+		if !ok {
+			return s, fmt.Errorf("%s is not declared in the sysconfig template", key)
+		}
+		f := v.Field(i)
+		switch f.Kind() {
+		case reflect.Bool:
+			f.SetBool(value == "yes")
+		case reflect.Int:
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return s, fmt.Errorf("invalid value %q for %s: must be an integer", value, key)
+			}
+			f.SetInt(int64(n))
+		default:
+			f.SetString(value)
+		}
+	}
+	return s, nil
+}
@@ -2,103 +2,239 @@ package sysconfig
 
 import (
 	"bufio"
+	"bytes"
 	"io"
-	"reflect"
-	"strconv"
+	"sort"
 	"strings"
 )
 
-// Config dump /etc/sysconfig/*.sysconfig to golang structs
-type Config map[string]interface{}
+// Config dump /etc/sysconfig/*.sysconfig to a map of variables and their unquoted values.
+// values are kept as written, Schema gives them a type.
+type Config map[string]string
 
-// Bool find a bool value
-func (cfg Config) Bool(s string) bool {
-	if val, ok := cfg[s]; ok {
-		b, _ := val.(bool)
-		return b
+// assignment a KEY=value line split into its parts, so it can be written back with another value
+type assignment struct {
+	// prefix everything up to and including "="
+	prefix string
+	key    string
+	value  string
+	// quote the quote character used around the value, 0 for none
+	quote byte
+	// rest whatever follows the value, eg. a comment
+	rest string
+}
+
+// parseAssignment split line into an assignment, ok is false for comments, blank lines and garbage
+func parseAssignment(line string) (a assignment, ok bool) {
+	trimmed := strings.TrimSpace(line)
+	if strings.HasPrefix(trimmed, "#") || len(trimmed) == 0 {
+		return a, false
 	}
-	return false
+	i := strings.Index(line, "=")
+	if i < 0 {
+		return a, false
+	}
+	a.prefix = line[:i+1]
+	a.key = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line[:i]), "export "))
+	a.value, a.quote, a.rest = splitValue(line[i+1:])
+	return a, len(a.key) > 0
 }
 
-// Int find an integer value
-func (cfg Config) Int(s string) int {
-	if val, ok := cfg[s]; ok {
-		i, _ := val.(int)
-		return i
+// splitValue split the right hand side of an assignment into its unquoted value, the quote used and what follows it
+func splitValue(s string) (string, byte, string) {
+	if len(s) == 0 {
+		return "", 0, ""
+	}
+	switch s[0] {
+	case '"':
+		var value strings.Builder
+		for i := 1; i < len(s); i++ {
+			switch {
+			case s[i] == '\\' && i+1 < len(s) && strings.ContainsRune("\"\\$`", rune(s[i+1])):
+				i++
+				value.WriteByte(s[i])
+			case s[i] == '"':
+				return value.String(), '"', s[i+1:]
+			default:
+				value.WriteByte(s[i])
+			}
+		}
+		// unterminated, take it as it is
+		return value.String(), '"', ""
+	case '\'':
+		if i := strings.Index(s[1:], "'"); i >= 0 {
+			return s[1 : i+1], '\'', s[i+2:]
+		}
+		return s[1:], '\'', ""
 	}
-	return 0
+	if i := strings.IndexAny(s, " \t\n#"); i >= 0 {
+		return s[:i], 0, s[i:]
+	}
+	return s, 0, ""
 }
 
-// String find a string value
-func (cfg Config) String(s string) string {
-	if val, ok := cfg[s]; ok {
-		s1, _ := val.(string)
-		return s1
+// quoteValue quote value for the shell, in the style of quote if it can hold value
+func quoteValue(value string, quote byte) string {
+	switch {
+	case quote == '\'' && !strings.Contains(value, "'"):
+		return "'" + value + "'"
+	case quote == 0 && len(value) > 0 && strings.Trim(value, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_.:/,+") == "":
+		return value
+	}
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range value {
+		if strings.ContainsRune("\"\\$`", r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
 	}
-	return ""
+	b.WriteByte('"')
+	return b.String()
 }
 
-// Marshal sysconfig to bytes again from template f.
-func (cfg Config) Marshal(f io.Reader, b []byte) {
-	s := bufio.NewScanner(f)
-	for s.Scan() {
-		if strings.HasPrefix(s.Text(), "#") || len(s.Text()) == 0 {
-			for _, v := range s.Bytes() {
-				b = append(b, v)
-			}
+// parseLine split a KEY="value" line into the key and the unquoted value
+func parseLine(line string) (string, string, bool) {
+	a, ok := parseAssignment(line)
+	return a.key, a.value, ok
+}
+
+// unquote strip the double or single quotes around s
+func unquote(s string) string {
+	if len(s) == 0 || (s[0] != '"' && s[0] != '\'') {
+		return s
+	}
+	value, _, _ := splitValue(s)
+	return value
+}
+
+// templateBlocks the comment block documenting every variable of template, including its trailing newline
+func templateBlocks(template []byte) map[string]string {
+	blocks := make(map[string]string)
+	var block string
+	for _, line := range strings.SplitAfter(string(template), "\n") {
+		if a, ok := parseAssignment(line); ok {
+			blocks[a.key] = block
+			block = ""
 			continue
 		}
-		arr := strings.Split(strings.ReplaceAll(s.Text(), "\"", ""), "=")
-		if val, ok := cfg[arr[0]]; ok {
-			if reflect.TypeOf(val).Kind() == reflect.Int {
-				i, _ := val.(int)
-				val = i
-			}
-			if reflect.TypeOf(val).Kind() == reflect.Bool {
-				b, _ := strconv.ParseBool(arr[1])
-				if b == val {
-					continue
-				}
-				ok, _ := val.(bool)
-				val = strconv.FormatBool(ok)
-			}
-			s1, _ := val.(string)
-			for _, v := range []byte(arr[0] + "=\"" + s1 + "\"") {
-				b = append(b, v)
+		if len(strings.TrimSpace(line)) == 0 {
+			block = ""
+			continue
+		}
+		block += line
+	}
+	return blocks
+}
+
+// Marshal rewrite the variables of the sysconfig file src with the values in cfg. comments, metadata,
+// ordering, the quoting style of every line and variables unknown to cfg are kept as they are.
+// variables of cfg missing in src are appended in the order of template, with their documentation.
+func (cfg Config) Marshal(src, template []byte) []byte {
+	var buf bytes.Buffer
+	seen := make(map[string]bool)
+
+	for _, line := range strings.SplitAfter(string(src), "\n") {
+		a, ok := parseAssignment(line)
+		if !ok {
+			buf.WriteString(line)
+			continue
+		}
+		seen[a.key] = true
+		value, ok := cfg[a.key]
+		if !ok || value == a.value {
+			buf.WriteString(line)
+			continue
+		}
+		buf.WriteString(a.prefix + quoteValue(value, a.quote) + a.rest)
+	}
+
+	var missing []string
+	for _, line := range strings.SplitAfter(string(template), "\n") {
+		if a, ok := parseAssignment(line); ok {
+			if _, ok := cfg[a.key]; ok && !seen[a.key] {
+				missing = append(missing, a.key)
+				seen[a.key] = true
 			}
 		}
 	}
+	var unknown []string
+	for k := range cfg {
+		if !seen[k] {
+			unknown = append(unknown, k)
+		}
+	}
+	sort.Strings(unknown)
+	missing = append(missing, unknown...)
+
+	if len(missing) > 0 && buf.Len() > 0 && !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+		buf.WriteString("\n")
+	}
+	blocks := templateBlocks(template)
+	for _, k := range missing {
+		if buf.Len() > 0 {
+			buf.WriteString("\n")
+		}
+		buf.WriteString(blocks[k] + k + "=" + quoteValue(cfg[k], '"') + "\n")
+	}
+
+	return buf.Bytes()
 }
 
 // Unmarshal unmarshal sysconfig
 func (cfg Config) Unmarshal(f io.Reader) {
 	s := bufio.NewScanner(f)
 	for s.Scan() {
-		if strings.HasPrefix(s.Text(), "#") || len(s.Text()) == 0 {
-			continue
+		if key, value, ok := parseLine(s.Text()); ok {
+			cfg[key] = value
 		}
-		arr := strings.Split(strings.ReplaceAll(s.Text(), "\"", ""), "=")
-		if len(arr) > 1 {
-			val, err := strconv.Atoi(arr[1])
-			if err != nil {
-				switch arr[1] {
-				case "yes":
-					arr[1] = "true"
-				case "no":
-					arr[1] = "false"
-				}
-				b, err1 := strconv.ParseBool(arr[1])
-				if err1 != nil {
-					cfg[arr[0]] = arr[1]
-					continue
+	}
+}
+
+// RemoveVariables drop the assignments of keys from the sysconfig file src, together with the comment block
+// documenting them
+func RemoveVariables(src []byte, keys ...string) []byte {
+	var out, block []string
+	drop := false
+
+	for _, line := range strings.SplitAfter(string(src), "\n") {
+		if a, ok := parseAssignment(line); ok {
+			remove := false
+			for _, k := range keys {
+				if a.key == k {
+					remove = true
+					break
 				}
-				cfg[arr[0]] = b
+			}
+			if remove {
+				// the blank line separating the block from the next one goes too
+				block = nil
+				drop = true
 				continue
 			}
-			cfg[arr[0]] = val
+			out = append(out, block...)
+			out = append(out, line)
+			block = nil
+			drop = false
+			continue
+		}
+		if len(strings.TrimSpace(line)) == 0 {
+			out = append(out, block...)
+			block = nil
+			if !drop && len(line) > 0 {
+				out = append(out, line)
+			}
+			drop = false
 			continue
 		}
-		var face interface{}
-		cfg[arr[0]] = face
+		block = append(block, line)
+	}
+	out = append(out, block...)
+
+	str := strings.Join(out, "")
+	// no blank line left dangling at the end by the last block going away
+	for strings.HasSuffix(str, "\n\n") && !strings.HasSuffix(string(src), "\n\n") {
+		str = strings.TrimSuffix(str, "\n")
 	}
+	return []byte(str)
 }
@@ -0,0 +1,122 @@
+package sysconfig
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseAssignment(t *testing.T) {
+	tests := []struct {
+		line string
+		ok   bool
+		want assignment
+	}{
+		{`A="yes"`, true, assignment{`A=`, "A", "yes", '"', ""}},
+		{`export  B='a b' # note`, true, assignment{`export  B=`, "B", "a b", '\'', " # note"}},
+		{`C=plain # note`, true, assignment{`C=`, "C", "plain", 0, " # note"}},
+		{`D="say \"hi\" \$HOME"`, true, assignment{`D=`, "D", `say "hi" $HOME`, '"', ""}},
+		{`E=`, true, assignment{`E=`, "E", "", 0, ""}},
+		{`F="unterminated`, true, assignment{`F=`, "F", "unterminated", '"', ""}},
+		{"# G=\"commented\"", false, assignment{}},
+		{"", false, assignment{}},
+		{"no assignment", false, assignment{}},
+		{`="no key"`, false, assignment{}},
+	}
+	for _, tt := range tests {
+		a, ok := parseAssignment(tt.line)
+		if ok != tt.ok || (ok && a != tt.want) {
+			t.Errorf("parseAssignment(%q) = %#v, %t, want %#v, %t", tt.line, a, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestQuoteValue(t *testing.T) {
+	tests := []struct {
+		value string
+		quote byte
+		want  string
+	}{
+		{"yes", '"', `"yes"`},
+		{"yes", 0, "yes"},
+		{"a b", 0, `"a b"`},
+		{"", 0, `""`},
+		{"a b", '\'', "'a b'"},
+		{"it's", '\'', `"it's"`},
+		{`$x "y"`, '"', `"\$x \"y\""`},
+	}
+	for _, tt := range tests {
+		if got := quoteValue(tt.value, tt.quote); got != tt.want {
+			t.Errorf("quoteValue(%q, %q) = %s, want %s", tt.value, tt.quote, got, tt.want)
+		}
+	}
+}
+
+// testTemplate a fillup template declaring three variables
+const testTemplate = `## Type: yesno
+## Default: no
+#
+# Force black and white.
+#
+FORCE_BW="no"
+
+## Type: string
+#
+# Preferred sans families.
+#
+PREFER_SANS_FAMILIES=""
+
+## Type: integer
+#
+# Threshold.
+#
+THRESHOLD="0"
+`
+
+func TestMarshal(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		cfg  Config
+		want string
+	}{
+		{"unchanged", "# header\nFORCE_BW=\"no\"\n", Config{"FORCE_BW": "no"}, "# header\nFORCE_BW=\"no\"\n"},
+		{"quoting kept",
+			"# header\nFORCE_BW='no' # trailing\nexport PREFER_SANS_FAMILIES=DejaVu\n",
+			Config{"FORCE_BW": "yes", "PREFER_SANS_FAMILIES": "Noto Sans"},
+			"# header\nFORCE_BW='yes' # trailing\nexport PREFER_SANS_FAMILIES=\"Noto Sans\"\n"},
+		{"unknown variables kept", "OLD=\"1\"\nFORCE_BW=\"no\"\n", Config{"FORCE_BW": "yes"}, "OLD=\"1\"\nFORCE_BW=\"yes\"\n"},
+		{"missing appended in template order",
+			"FORCE_BW=\"no\"",
+			Config{"FORCE_BW": "no", "THRESHOLD": "8", "PREFER_SANS_FAMILIES": "a:b", "EXTRA": "x"},
+			"FORCE_BW=\"no\"\n\n## Type: string\n#\n# Preferred sans families.\n#\nPREFER_SANS_FAMILIES=\"a:b\"\n" +
+				"\n## Type: integer\n#\n# Threshold.\n#\nTHRESHOLD=\"8\"\n\nEXTRA=\"x\"\n"},
+		{"empty source", "", Config{"FORCE_BW": "yes"}, "## Type: yesno\n## Default: no\n#\n# Force black and white.\n#\nFORCE_BW=\"yes\"\n"},
+	}
+	for _, tt := range tests {
+		if got := string(tt.cfg.Marshal([]byte(tt.src), []byte(testTemplate))); got != tt.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	src := "# comment\nFORCE_BW='yes'\nPREFER_SANS_FAMILIES=\"a \\\"b\\\"\" # note\n"
+	cfg := make(Config)
+	cfg.Unmarshal(strings.NewReader(src))
+	want := Config{"FORCE_BW": "yes", "PREFER_SANS_FAMILIES": `a "b"`}
+	if !reflect.DeepEqual(cfg, want) {
+		t.Fatalf("unmarshaled %#v, want %#v", cfg, want)
+	}
+	if got := string(cfg.Marshal([]byte(src), []byte(testTemplate))); got != src {
+		t.Errorf("got\n%s\nwant\n%s", got, src)
+	}
+}
+
+func TestRemoveVariables(t *testing.T) {
+	got := string(RemoveVariables([]byte(testTemplate), "PREFER_SANS_FAMILIES", "THRESHOLD"))
+	want := "## Type: yesno\n## Default: no\n#\n# Force black and white.\n#\nFORCE_BW=\"no\"\n"
+	if got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}